@@ -1,8 +1,6 @@
 package asky
 
-import (
-	"strconv"
-)
+import "strconv"
 
 const (
 	ansiHideCursor = "\033[?25l"
@@ -11,11 +9,99 @@ const (
 	ansiReset       = "\033[0m\033[0 q"
 	ansiClearLine   = "\033[K"
 	ansiClearScreen = "\033[J"
+
+	ansiBell = "\a"
+
+	// Mouse button tracking with SGR extended reports
+	ansiMouseOn  = "\033[?1000h\033[?1006h"
+	ansiMouseOff = "\033[?1006l\033[?1000l"
 )
 
+// ANSI exposes the escape sequences asky uses for cursor and line control,
+// so custom output can be interleaved with prompts without redefining them.
+//
+//	os.Stdout.WriteString(asky.ANSI.CursorUp(2) + asky.ANSI.ClearLine)
+var ANSI = ansiSequences{
+	HideCursor:  ansiHideCursor,
+	ShowCursor:  ansiShowCursor,
+	Reset:       ansiReset,
+	ClearLine:   ansiClearLine,
+	ClearScreen: ansiClearScreen,
+	Bell:        ansiBell,
+}
+
+// ansiSequences is the type of [ANSI].
+type ansiSequences struct {
+	HideCursor  string // hides the cursor
+	ShowCursor  string // shows the cursor
+	Reset       string // resets text attributes and the cursor shape
+	ClearLine   string // erases from the cursor to the end of the line
+	ClearScreen string // erases from the cursor to the end of the screen
+	Bell        string // rings the terminal bell
+}
+
+// CursorUp returns the sequence that moves the cursor n rows up,
+// or "" when n is not positive.
+func (ansiSequences) CursorUp(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return "\033[" + strconv.Itoa(n) + "A"
+}
+
+// CursorDown returns the sequence that moves the cursor n rows down,
+// or "" when n is not positive.
+func (ansiSequences) CursorDown(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return "\033[" + strconv.Itoa(n) + "B"
+}
+
+// CursorStyle selects the terminal cursor shape shown while a text prompt
+// is active, using the DECSCUSR sequence. Terminals that do not support it
+// ignore the setting.
+type CursorStyle uint8
+
+const (
+	CursorDefault           CursorStyle = iota // terminal's configured cursor
+	CursorBlinkingBlock                        // blinking block
+	CursorBlock                                // steady block
+	CursorBlinkingUnderline                    // blinking underline
+	CursorUnderline                            // steady underline
+	CursorBlinkingBar                          // blinking vertical bar
+	CursorBar                                  // steady vertical bar
+)
+
+// ansiCursorStyle writes the DECSCUSR sequence for style.
+// The default shape is restored by [ansiReset].
+func ansiCursorStyle(style CursorStyle) {
+	if style != CursorDefault {
+		stdOutput.Write([]byte("\033[" + strconv.Itoa(int(style)) + " q"))
+	}
+}
+
+// restoreTerminal clears a prompt frame whose first row is rowsUp rows
+// above the cursor, then resets colors, cursor shape and visibility.
+// Interactive prompts defer it as soon as they first write to the
+// terminal, so every exit path, including a panic in a user hook, leaves
+// the terminal as it was found.
+func restoreTerminal(rowsUp int) {
+	ansiCursorUp(rowsUp)
+	stdOutput.Write([]byte("\r" + ansiClearScreen + ansiReset + ansiShowCursor))
+}
+
 // ansiCursorUp moves the cursor n positions up.
 func ansiCursorUp(n int) {
 	if n > 0 {
-		stdOutput.Write([]byte("\033[" + strconv.Itoa(n) + "A"))
+		stdOutput.Write([]byte(ANSI.CursorUp(n)))
+	}
+}
+
+// bell rings the terminal bell. Nothing is written when stdout is not a
+// terminal, so piped or redirected output never receives a stray BEL.
+func bell() {
+	if outputIsTerminal() {
+		stdOutput.Write([]byte(ansiBell))
 	}
 }
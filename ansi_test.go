@@ -0,0 +1,49 @@
+package asky
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRestoreTerminal(t *testing.T) {
+	tests := []struct {
+		name   string
+		rowsUp int
+		want   string
+	}{
+		{"single row", 0, "\r\033[J\033[0m\033[0 q\033[?25h"},
+		{"taller frame", 3, "\033[3A\r\033[J\033[0m\033[0 q\033[?25h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			SetOutput(&buf)
+			defer SetOutput(nil)
+
+			restoreTerminal(tt.rowsUp)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("restoreTerminal(%d) wrote %q, want %q", tt.rowsUp, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestoreTerminalOnPanic(t *testing.T) {
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	defer SetOutput(nil)
+
+	// A prompt frame interrupted by a panic in a user hook still ends with
+	// the terminal restored
+	func() {
+		defer func() { _ = recover() }()
+		stdOutput.Write([]byte(ansiHideCursor + "frame"))
+		defer restoreTerminal(0)
+		panic("hook failed")
+	}()
+
+	want := ansiHideCursor + "frame\r" + ansiClearScreen + ansiReset + ansiShowCursor
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+package asky
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// TermCapabilities describes what the attached terminal is expected to
+// support. Obtain one with [Capabilities].
+type TermCapabilities struct {
+	// IsTTY reports whether stdin and stdout are both terminals, which
+	// interactive prompts require.
+	IsTTY bool
+
+	// ColorDepth is the number of color bits available: 0 when color is
+	// disabled, 4 for the basic 16 colors, 8 for 256 colors and 24 for
+	// truecolor.
+	ColorDepth int
+
+	// Width and Height are the terminal dimensions in columns and rows,
+	// or zero when stdout is not a terminal.
+	Width, Height int
+
+	// SupportsUnicode reports whether the locale (or Windows Terminal on
+	// Windows) is expected to render non-ASCII symbols such as the default
+	// prefixes and spinner frames.
+	SupportsUnicode bool
+}
+
+// Capabilities inspects the current terminal and environment. Call it
+// before prompting to choose ASCII patterns, plain output or
+// [Config.Accessible] up front.
+//
+//	caps := asky.Capabilities()
+//	if !caps.IsTTY {
+//	    asky.Configure(asky.Config{Accessible: true})
+//	}
+func Capabilities() TermCapabilities {
+	caps := TermCapabilities{
+		IsTTY: term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd())),
+	}
+	if w, h, err := termSize(); err == nil {
+		caps.Width, caps.Height = w, h
+	}
+	caps.ColorDepth = colorDepth()
+
+	if runtime.GOOS == "windows" {
+		caps.SupportsUnicode = os.Getenv("WT_SESSION") != ""
+	} else {
+		caps.SupportsUnicode = os.Getenv("TERM") != "linux" && utf8Locale()
+	}
+	return caps
+}
+
+// colorDepth estimates the color depth from the environment, honoring
+// the same NO_COLOR and non-TTY rules as fatih/color.
+func colorDepth() int {
+	if color.NoColor {
+		return 0
+	}
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return 24
+	}
+	if runtime.GOOS == "windows" && os.Getenv("WT_SESSION") != "" {
+		return 24
+	}
+	if strings.Contains(os.Getenv("TERM"), "256color") {
+		return 8
+	}
+	return 4
+}
@@ -1,6 +1,10 @@
 package asky
 
-import "github.com/fatih/color"
+import (
+	"os"
+
+	"github.com/fatih/color"
+)
 
 // Config holds package-level configuration for all Asky components.
 // Set once at program startup using [Configure].
@@ -16,6 +20,18 @@ type Config struct {
 	// pipelines, and plain or piped terminal environments.
 	Accessible bool
 
+	// NoSignalHandling stops [Spinner] and [Progress] from installing their
+	// own SIGINT/SIGTERM handler, which otherwise restores the terminal and
+	// exits the process. Set this when the host program owns signal handling
+	// and stops spinners and progress bars itself.
+	NoSignalHandling bool
+
+	// ReducedMotion replaces animated spinner frames with a static "[…]"
+	// frame that only redraws when the label changes, and stops the
+	// animated prefix of progress bars. Defaults to on when the
+	// ASKY_REDUCED_MOTION environment variable is set to anything but "0".
+	ReducedMotion bool
+
 	// Styles sets the [StyleMap] used by all Asky components.
 	// Defaults to [NewStyles] if not set.
 	Styles *StyleMap
@@ -23,7 +39,14 @@ type Config struct {
 
 // pkgConfig holds the active package-level configuration.
 var pkgConfig = Config{
-	Styles: NewStyles(),
+	Styles:        defaultStyles,
+	ReducedMotion: reducedMotionEnv(),
+}
+
+// reducedMotionEnv reports whether ASKY_REDUCED_MOTION requests reduced motion.
+func reducedMotionEnv() bool {
+	v := os.Getenv("ASKY_REDUCED_MOTION")
+	return v != "" && v != "0"
 }
 
 // Configure sets package-level defaults for all Asky components.
@@ -40,6 +63,12 @@ func Configure(c Config) {
 	if c.Accessible {
 		pkgConfig.Accessible = true
 	}
+	if c.NoSignalHandling {
+		pkgConfig.NoSignalHandling = true
+	}
+	if c.ReducedMotion {
+		pkgConfig.ReducedMotion = true
+	}
 	if c.Styles != nil {
 		pkgConfig.Styles = c.Styles
 	}
@@ -15,3 +15,15 @@ var ErrNoSelectionChoices = errors.New("no choices supplied for selection prompt
 // ErrInvalidSelectionBounds is returned when min count exceeds max count
 // in a multi-select prompt configuration.
 var ErrInvalidSelectionBounds = errors.New("min count must not exceed max count for multi select prompt")
+
+// ErrStopped is returned when a key handler installed with WithKeyHandler
+// asks the prompt to exit.
+var ErrStopped = errors.New("prompt stopped by key handler")
+
+// ErrTooManyAttempts is returned when a selection prompt's validator rejects
+// more submissions than allowed by WithMaxAttempts.
+var ErrTooManyAttempts = errors.New("too many failed validation attempts")
+
+// ErrEOF is returned when the user ends input with Ctrl+D on an empty line
+// of a text prompt.
+var ErrEOF = errors.New("end of input")
@@ -3,41 +3,65 @@ package asky
 import (
 	"bufio"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
+	"unicode"
 
 	"golang.org/x/term"
 )
 
-// keyCode represents a parsed terminal key event.
-type keyCode int
+// KeyCode identifies a parsed terminal key. Printable characters are
+// reported as [KeyRune] with the character in [Key.Rune].
+type KeyCode int
 
 const (
-	keyRune      keyCode = iota // printable character
-	keyTab                      // \x09
-	keySpace                    // \x20
-	keyEnter                    // \r or \n
-	keyBackspace                // \x7f or \x08
-	keyDelete                   // \x1b[3~
-	keyLeft                     // \x1b[D or \x1bOD
-	keyRight                    // \x1b[C or \x1bOC
-	keyUp                       // \x1b[A or \x1bOA
-	keyDown                     // \x1b[B or \x1bOB
-	keyHome                     // \x1b[H, \x1b[1~, or \x1bOH
-	keyEnd                      // \x1b[F, \x1b[4~, or \x1bOF
-	keyEscape                   // standalone \x1b (distinguished via timeout)
-	keyCtrlC                    // \x03
-	keyCtrlD                    // \x04
-	keyCtrlLeft                 // \x1b[1;5D
-	keyCtrlRight                // \x1b[1;5C
-	keyCtrlHome                 // \x1b[1;5H
-	keyCtrlEnd                  // \x1b[1;5F
-	keyUnknown
+	KeyRune      KeyCode = iota // printable character
+	KeyTab                      // \x09
+	KeySpace                    // \x20
+	KeyEnter                    // \r or \n
+	KeyBackspace                // \x7f or \x08
+	KeyDelete                   // \x1b[3~
+	KeyLeft                     // \x1b[D or \x1bOD
+	KeyRight                    // \x1b[C or \x1bOC
+	KeyUp                       // \x1b[A or \x1bOA
+	KeyDown                     // \x1b[B or \x1bOB
+	KeyHome                     // \x1b[H, \x1b[1~, or \x1bOH
+	KeyEnd                      // \x1b[F, \x1b[4~, or \x1bOF
+	KeyEscape                   // standalone \x1b (distinguished via timeout)
+	KeyCtrlC                    // \x03
+	KeyCtrlD                    // \x04
+	KeyCtrlLeft                 // \x1b[1;5D
+	KeyCtrlRight                // \x1b[1;5C
+	KeyCtrlHome                 // \x1b[1;5H
+	KeyCtrlEnd                  // \x1b[1;5F
+	KeyF1                       // \x1bOP or \x1b[11~
+	KeyF2                       // \x1bOQ or \x1b[12~
+	KeyF3                       // \x1bOR or \x1b[13~
+	KeyF4                       // \x1bOS or \x1b[14~
+	KeyF5                       // \x1b[15~
+	KeyF6                       // \x1b[17~
+	KeyF7                       // \x1b[18~
+	KeyF8                       // \x1b[19~
+	KeyF9                       // \x1b[20~
+	KeyF10                      // \x1b[21~
+	KeyF11                      // \x1b[23~
+	KeyF12                      // \x1b[24~
+	KeyShiftTab                 // \x1b[Z
+	KeyCtrlL                    // \x0c
+	KeyCtrlS                    // \x13
+	KeyWheelUp                  // mouse wheel up, see WithMouse
+	KeyWheelDown                // mouse wheel down, see WithMouse
+	KeyUnknown
 )
 
-// keyEvent is a parsed key press.
-type keyEvent struct {
-	code keyCode
-	r    rune // set when code == keyRune
+// Key is a parsed key press, as passed to prompt key handlers
+// installed with WithKeyHandler.
+type Key struct {
+	Code KeyCode
+	Rune rune // set when Code == KeyRune
 }
 
 // escTimeout is how long to wait after a bare \x1b before treating it as
@@ -51,6 +75,18 @@ type keyReader struct {
 	fd       int
 	oldState *term.State
 	r        *bufio.Reader
+
+	// wait polls for input; nil where stdin cannot be polled, in which
+	// case ready reads in the background and keeps the byte for readByte
+	wait    func(timeout time.Duration) (bool, error)
+	pending chan byteResult
+	peeked  *byteResult
+}
+
+// byteResult is the outcome of a background read on the fallback path.
+type byteResult struct {
+	b   byte
+	err error
 }
 
 // newKeyReader puts stdin into raw mode and returns a keyReader.
@@ -64,22 +100,75 @@ func newKeyReader() (*keyReader, error) {
 		fd:       fd,
 		oldState: old,
 		r:        bufio.NewReaderSize(os.Stdin, 64),
+		wait:     inputWaiter(fd),
 	}, nil
 }
 
+// openKeyReader opens the key reader for listenKeys. Tests replace it with
+// a reader over fake input.
+var openKeyReader = newKeyReader
+
 // close restores the terminal to its original state.
 func (kr *keyReader) close() {
-	term.Restore(kr.fd, kr.oldState) //nolint:errcheck
+	if kr.oldState != nil {
+		term.Restore(kr.fd, kr.oldState) //nolint:errcheck
+	}
 }
 
-// read blocks until a key is pressed and returns a keyEvent.
-// It handles Escape ambiguity by attempting a short buffered read after
-// a bare \x1b — if no further bytes arrive within escTimeout, it returns
-// keyEscape; otherwise it reads the full sequence and parses it.
-func (kr *keyReader) read() (keyEvent, error) {
-	first, err := kr.r.ReadByte()
+// ready reports whether a key can be read without blocking, waiting at
+// most timeout for one to arrive. Without a way to poll stdin, it starts
+// a background read instead; a byte that arrives after the timeout is
+// kept for the next readByte rather than lost.
+func (kr *keyReader) ready(timeout time.Duration) (bool, error) {
+	if kr.peeked != nil {
+		return true, nil
+	}
+	if kr.pending == nil {
+		if kr.r.Buffered() > 0 {
+			return true, nil
+		}
+		if kr.wait != nil {
+			return kr.wait(timeout)
+		}
+		kr.pending = make(chan byteResult, 1)
+		go func(ch chan<- byteResult) {
+			b, err := kr.r.ReadByte()
+			ch <- byteResult{b, err}
+		}(kr.pending)
+	}
+	select {
+	case res := <-kr.pending:
+		kr.pending, kr.peeked = nil, &res
+		return true, nil
+	case <-time.After(timeout):
+		return false, nil
+	}
+}
+
+// readByte returns the next input byte, taking it from a background read
+// started by ready when there is one.
+func (kr *keyReader) readByte() (byte, error) {
+	switch {
+	case kr.peeked != nil:
+		res := *kr.peeked
+		kr.peeked = nil
+		return res.b, res.err
+	case kr.pending != nil:
+		res := <-kr.pending
+		kr.pending = nil
+		return res.b, res.err
+	}
+	return kr.r.ReadByte()
+}
+
+// read blocks until a key is pressed and returns a Key.
+// It handles Escape ambiguity by waiting briefly for input after a bare
+// \x1b — if no further bytes arrive within escTimeout, it returns
+// KeyEscape; otherwise it reads the full sequence and parses it.
+func (kr *keyReader) read() (Key, error) {
+	first, err := kr.readByte()
 	if err != nil {
-		return keyEvent{code: keyUnknown}, err
+		return Key{Code: KeyUnknown}, err
 	}
 
 	// Not an escape byte — handle immediately.
@@ -87,71 +176,71 @@ func (kr *keyReader) read() (keyEvent, error) {
 		return parseSingleOrUTF8(first, kr.r)
 	}
 
-	// It's 0x1b. Peek ahead with a timeout to decide: sequence or bare Escape.
-	type peekResult struct {
-		b   byte
-		err error
-	}
-	ch := make(chan peekResult, 1)
-	go func() {
-		b, err := kr.r.ReadByte()
-		ch <- peekResult{b, err}
-	}()
-
-	select {
-	case <-time.After(escTimeout):
+	// It's 0x1b. Wait briefly to decide: sequence or bare Escape. A byte
+	// that arrives too late is kept for the next read, not swallowed.
+	if ok, err := kr.ready(escTimeout); err != nil || !ok {
 		// Nothing followed within the timeout — standalone Escape.
-		return keyEvent{code: keyEscape}, nil
+		return Key{Code: KeyEscape}, nil
+	}
+	next, err := kr.readByte()
+	if err != nil {
+		return Key{Code: KeyEscape}, nil
+	}
 
-	case peek := <-ch:
-		if peek.err != nil {
-			return keyEvent{code: keyEscape}, nil
+	switch next {
+	case 'O':
+		// SS3 sequences: \x1bO... — xterm application cursor mode, tmux, VT100.
+		third, err := kr.r.ReadByte()
+		if err != nil {
+			return Key{Code: KeyEscape}, nil
 		}
+		switch third {
+		case 'A':
+			return Key{Code: KeyUp}, nil
+		case 'B':
+			return Key{Code: KeyDown}, nil
+		case 'C':
+			return Key{Code: KeyRight}, nil
+		case 'D':
+			return Key{Code: KeyLeft}, nil
+		case 'H':
+			return Key{Code: KeyHome}, nil
+		case 'F':
+			return Key{Code: KeyEnd}, nil
+		case 'P':
+			return Key{Code: KeyF1}, nil
+		case 'Q':
+			return Key{Code: KeyF2}, nil
+		case 'R':
+			return Key{Code: KeyF3}, nil
+		case 'S':
+			return Key{Code: KeyF4}, nil
+		}
+		return Key{Code: KeyUnknown}, nil
 
-		switch peek.b {
-		case 'O':
-			// SS3 sequences: \x1bO... — xterm application cursor mode, tmux, VT100.
-			third, err := kr.r.ReadByte()
-			if err != nil {
-				return keyEvent{code: keyEscape}, nil
-			}
-			switch third {
-			case 'A':
-				return keyEvent{code: keyUp}, nil
-			case 'B':
-				return keyEvent{code: keyDown}, nil
-			case 'C':
-				return keyEvent{code: keyRight}, nil
-			case 'D':
-				return keyEvent{code: keyLeft}, nil
-			case 'H':
-				return keyEvent{code: keyHome}, nil
-			case 'F':
-				return keyEvent{code: keyEnd}, nil
-			}
-			return keyEvent{code: keyUnknown}, nil
-
-		case '[':
-			// CSI sequences: \x1b[...
-			return kr.readCSI()
+	case '[':
+		// CSI sequences: \x1b[...
+		return kr.readCSI()
 
-		default:
-			// Unrecognised sequence after \x1b (e.g. Alt+key — not used by asky yet).
-			return keyEvent{code: keyUnknown}, nil
-		}
+	default:
+		// Unrecognised sequence after \x1b (e.g. Alt+key — not used by asky yet).
+		return Key{Code: KeyUnknown}, nil
 	}
 }
 
 // readCSI reads the remainder of a CSI sequence (\x1b[ already consumed)
-// and maps it to a keyEvent.
-func (kr *keyReader) readCSI() (keyEvent, error) {
-	// Read up to 6 bytes — enough for any sequence asky handles.
-	// CSI sequences terminate on a final byte in range 0x40–0x7E.
-	buf := make([]byte, 0, 6)
-	for len(buf) < 6 {
+// and maps it to a Key.
+//
+// The whole sequence is consumed even when asky does not handle it, so that
+// mouse reports and bracketed-paste markers never leak into input as runes.
+func (kr *keyReader) readCSI() (Key, error) {
+	// CSI sequences terminate on a final byte in range 0x40–0x7E. The cap
+	// only guards against malformed input that never terminates.
+	buf := make([]byte, 0, 8)
+	for len(buf) < csiMaxLen {
 		b, err := kr.r.ReadByte()
 		if err != nil {
-			return keyEvent{code: keyUnknown}, err
+			return Key{Code: KeyUnknown}, err
 		}
 		buf = append(buf, b)
 		if b >= 0x40 && b <= 0x7e {
@@ -159,71 +248,131 @@ func (kr *keyReader) readCSI() (keyEvent, error) {
 		}
 	}
 
+	// X10 mouse reports (\x1b[M) carry three raw bytes after the final
+	// byte, the first being the button offset by 32.
+	if len(buf) == 1 && buf[0] == 'M' {
+		var report [3]byte
+		for i := range report {
+			b, err := kr.r.ReadByte()
+			if err != nil {
+				return Key{Code: KeyUnknown}, err
+			}
+			report[i] = b
+		}
+		return mouseWheelKey(int(report[0]) - 32), nil
+	}
+
+	// SGR mouse reports: \x1b[<button;col;row followed by M on press or m
+	// on release
+	if len(buf) > 1 && buf[0] == '<' && buf[len(buf)-1] == 'M' {
+		param, _, _ := strings.Cut(string(buf[1:len(buf)-1]), ";")
+		if button, err := strconv.Atoi(param); err == nil {
+			return mouseWheelKey(button), nil
+		}
+		return Key{Code: KeyUnknown}, nil
+	}
+
 	switch {
 	case len(buf) == 1 && buf[0] == 'A':
-		return keyEvent{code: keyUp}, nil
+		return Key{Code: KeyUp}, nil
 	case len(buf) == 1 && buf[0] == 'B':
-		return keyEvent{code: keyDown}, nil
+		return Key{Code: KeyDown}, nil
 	case len(buf) == 1 && buf[0] == 'C':
-		return keyEvent{code: keyRight}, nil
+		return Key{Code: KeyRight}, nil
 	case len(buf) == 1 && buf[0] == 'D':
-		return keyEvent{code: keyLeft}, nil
+		return Key{Code: KeyLeft}, nil
 	case len(buf) == 1 && buf[0] == 'H':
-		return keyEvent{code: keyHome}, nil
+		return Key{Code: KeyHome}, nil
 	case len(buf) == 1 && buf[0] == 'F':
-		return keyEvent{code: keyEnd}, nil
+		return Key{Code: KeyEnd}, nil
+	case len(buf) == 1 && buf[0] == 'Z':
+		return Key{Code: KeyShiftTab}, nil
 
 	// Home: \x1b[1~
 	case len(buf) == 2 && buf[0] == '1' && buf[1] == '~':
-		return keyEvent{code: keyHome}, nil
+		return Key{Code: KeyHome}, nil
 	// End: \x1b[4~
 	case len(buf) == 2 && buf[0] == '4' && buf[1] == '~':
-		return keyEvent{code: keyEnd}, nil
+		return Key{Code: KeyEnd}, nil
 	// Delete: \x1b[3~
 	case len(buf) == 2 && buf[0] == '3' && buf[1] == '~':
-		return keyEvent{code: keyDelete}, nil
+		return Key{Code: KeyDelete}, nil
+
+	// Function keys: \x1b[11~ through \x1b[24~
+	case len(buf) == 3 && buf[2] == '~':
+		if code, ok := csiFunctionKeys[string(buf[:2])]; ok {
+			return Key{Code: code}, nil
+		}
 
 	// Ctrl+Left: \x1b[1;5D
 	case len(buf) == 4 && buf[0] == '1' && buf[1] == ';' && buf[2] == '5' && buf[3] == 'D':
-		return keyEvent{code: keyCtrlLeft}, nil
+		return Key{Code: KeyCtrlLeft}, nil
 	// Ctrl+Right: \x1b[1;5C
 	case len(buf) == 4 && buf[0] == '1' && buf[1] == ';' && buf[2] == '5' && buf[3] == 'C':
-		return keyEvent{code: keyCtrlRight}, nil
+		return Key{Code: KeyCtrlRight}, nil
 	// Ctrl+Home: \x1b[1;5H
 	case len(buf) == 4 && buf[0] == '1' && buf[1] == ';' && buf[2] == '5' && buf[3] == 'H':
-		return keyEvent{code: keyCtrlHome}, nil
+		return Key{Code: KeyCtrlHome}, nil
 	// Ctrl+End: \x1b[1;5F
 	case len(buf) == 4 && buf[0] == '1' && buf[1] == ';' && buf[2] == '5' && buf[3] == 'F':
-		return keyEvent{code: keyCtrlEnd}, nil
+		return Key{Code: KeyCtrlEnd}, nil
+	}
+
+	return Key{Code: KeyUnknown}, nil
+}
+
+// mouseWheelKey maps the button number of a mouse report to a wheel key.
+// Buttons 64 and 65 are the wheel; the shift, meta and ctrl bits are
+// ignored. Clicks and motion map to [KeyUnknown].
+func mouseWheelKey(button int) Key {
+	switch button &^ 0x1c {
+	case 64:
+		return Key{Code: KeyWheelUp}
+	case 65:
+		return Key{Code: KeyWheelDown}
 	}
+	return Key{Code: KeyUnknown}
+}
+
+// csiMaxLen bounds how many bytes of a CSI sequence are read.
+const csiMaxLen = 32
 
-	return keyEvent{code: keyUnknown}, nil
+// csiFunctionKeys maps the numeric parameter of a \x1b[NN~ sequence to its
+// function key. Gaps in the numbering (16, 22) are part of the VT220 layout.
+var csiFunctionKeys = map[string]KeyCode{
+	"11": KeyF1, "12": KeyF2, "13": KeyF3, "14": KeyF4,
+	"15": KeyF5, "17": KeyF6, "18": KeyF7, "19": KeyF8,
+	"20": KeyF9, "21": KeyF10, "23": KeyF11, "24": KeyF12,
 }
 
 // parseSingleOrUTF8 handles a non-escape first byte: control/ASCII or the
 // start of a multi-byte UTF-8 rune. Continuation bytes are read from r.
-func parseSingleOrUTF8(first byte, r *bufio.Reader) (keyEvent, error) {
+func parseSingleOrUTF8(first byte, r *bufio.Reader) (Key, error) {
 	switch first {
 	case 0x03:
-		return keyEvent{code: keyCtrlC}, nil
+		return Key{Code: KeyCtrlC}, nil
 	case 0x04:
-		return keyEvent{code: keyCtrlD}, nil
+		return Key{Code: KeyCtrlD}, nil
+	case 0x0c:
+		return Key{Code: KeyCtrlL}, nil
+	case 0x13:
+		return Key{Code: KeyCtrlS}, nil
 	case 0x0d, 0x0a:
-		return keyEvent{code: keyEnter}, nil
+		return Key{Code: KeyEnter}, nil
 	case 0x7f, 0x08:
-		return keyEvent{code: keyBackspace}, nil
+		return Key{Code: KeyBackspace}, nil
 	case 0x09:
-		return keyEvent{code: keyTab}, nil
+		return Key{Code: KeyTab}, nil
 	case 0x20:
-		return keyEvent{code: keySpace}, nil
+		return Key{Code: KeySpace}, nil
 	}
 
 	// Printable ASCII.
 	if first < 0x80 {
 		if first >= 0x20 {
-			return keyEvent{code: keyRune, r: rune(first)}, nil
+			return Key{Code: KeyRune, Rune: rune(first)}, nil
 		}
-		return keyEvent{code: keyUnknown}, nil
+		return Key{Code: KeyUnknown}, nil
 	}
 
 	// Multi-byte UTF-8: determine expected sequence length from the leading byte.
@@ -236,7 +385,7 @@ func parseSingleOrUTF8(first byte, r *bufio.Reader) (keyEvent, error) {
 	case first&0xF8 == 0xF0:
 		seqLen = 4
 	default:
-		return keyEvent{code: keyUnknown}, nil
+		return Key{Code: KeyUnknown}, nil
 	}
 
 	buf := make([]byte, seqLen)
@@ -244,16 +393,16 @@ func parseSingleOrUTF8(first byte, r *bufio.Reader) (keyEvent, error) {
 	for i := 1; i < seqLen; i++ {
 		b, err := r.ReadByte()
 		if err != nil {
-			return keyEvent{code: keyUnknown}, err
+			return Key{Code: KeyUnknown}, err
 		}
 		buf[i] = b
 	}
 
 	rv, _ := decodeRune(buf)
-	if rv == 0xFFFD {
-		return keyEvent{code: keyUnknown}, nil
+	if rv == 0xFFFD || unicode.IsControl(rv) {
+		return Key{Code: KeyUnknown}, nil
 	}
-	return keyEvent{code: keyRune, r: rv}, nil
+	return Key{Code: KeyRune, Rune: rv}, nil
 }
 
 // decodeRune decodes the first UTF-8 rune in b.
@@ -288,8 +437,8 @@ func decodeRune(b []byte) (rune, int) {
 
 // listenKeys calls fn for each key press until fn returns true (stop) or an error.
 // Puts stdin into raw mode for the duration of the call.
-func listenKeys(fn func(keyEvent) (stop bool)) error {
-	kr, err := newKeyReader()
+func listenKeys(fn func(Key) (stop bool)) error {
+	kr, err := openKeyReader()
 	if err != nil {
 		return err
 	}
@@ -305,3 +454,136 @@ func listenKeys(fn func(keyEvent) (stop bool)) error {
 		}
 	}
 }
+
+// keyLabel returns the name of code as written in help lines, such as
+// "enter" or "ctrl+s".
+func keyLabel(code KeyCode) string {
+	switch {
+	case code >= KeyF1 && code <= KeyF12:
+		return "f" + strconv.Itoa(int(code-KeyF1)+1)
+	}
+	switch code {
+	case KeyTab:
+		return "tab"
+	case KeySpace:
+		return "space"
+	case KeyEnter:
+		return "enter"
+	case KeyEscape:
+		return "esc"
+	case KeyCtrlD:
+		return "ctrl+d"
+	case KeyCtrlL:
+		return "ctrl+l"
+	case KeyCtrlS:
+		return "ctrl+s"
+	}
+	return "submit key"
+}
+
+// remapSubmitKey translates ev for a prompt whose submit key was rebound
+// from native to submit. The submit key is reported as native, and native
+// itself is reported with ok false so the prompt ignores it. With submit
+// equal to native, ev is returned unchanged.
+func remapSubmitKey(ev Key, submit, native KeyCode) (_ Key, ok bool) {
+	switch {
+	case submit == native:
+		return ev, true
+	case ev.Code == submit:
+		return Key{Code: native}, true
+	case ev.Code == native:
+		return ev, false
+	}
+	return ev, true
+}
+
+// matches reports whether k is the same key press as o. Runes are only
+// compared for [KeyRune].
+func (k Key) matches(o Key) bool {
+	return k.Code == o.Code && (k.Code != KeyRune || k.Rune == o.Rune)
+}
+
+// watchKeys puts stdin into raw mode and reads keys in the background,
+// calling fn once for the first key that matches one of keys, or for
+// Ctrl+C (which raw mode no longer turns into SIGINT). Output processing
+// stays on, so lines printed meanwhile still start in the first column.
+// The returned stop function ends the watch and restores the terminal,
+// and is safe to call more than once, including from fn. On Unix and
+// Windows consoles stdin is polled rather than read blindly, so no read is
+// left pending once stop returns and later key presses reach the next
+// reader intact.
+func watchKeys(keys []Key, fn func(Key)) (stop func(), err error) {
+	kr, err := openWatchReader()
+	if err != nil {
+		return nil, err
+	}
+
+	// Whichever of stop and a matching key claims the state first wins: a
+	// stop already under way suppresses fn, and once fn is due, stop
+	// neither waits for the reader nor blocks a stop called from fn
+	var (
+		done   = make(chan struct{})
+		exited = make(chan struct{})
+		state  atomic.Int32
+	)
+	stop = func() {
+		if state.CompareAndSwap(watchRunning, watchStopped) {
+			close(done)
+			<-exited
+		}
+	}
+	go func() {
+		defer close(exited)
+		defer kr.close()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			ok, err := kr.ready(keyPollInterval)
+			if err != nil {
+				return
+			}
+			if !ok {
+				continue
+			}
+			ev, err := kr.read()
+			if err != nil {
+				return
+			}
+			if ev.Code == KeyCtrlC || slices.ContainsFunc(keys, ev.matches) {
+				if !state.CompareAndSwap(watchRunning, watchMatched) {
+					return
+				}
+				kr.close()
+				fn(ev)
+				return
+			}
+		}
+	}()
+	return stop, nil
+}
+
+// openWatchReader opens the key reader for watchKeys: stdin in raw mode,
+// with output processing turned back on. Tests replace it with a reader
+// over fake input.
+var openWatchReader = func() (*keyReader, error) {
+	kr, err := newKeyReader()
+	if err != nil {
+		return nil, err
+	}
+	restoreOutputProcessing(kr.fd)
+	return kr, nil
+}
+
+// States of a watchKeys watch.
+const (
+	watchRunning int32 = iota
+	watchStopped
+	watchMatched
+)
+
+// keyPollInterval is how often watchKeys checks whether it was stopped
+// while no key is pressed.
+const keyPollInterval = 50 * time.Millisecond
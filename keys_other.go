@@ -0,0 +1,14 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris && !zos && !windows
+
+package asky
+
+import "time"
+
+// inputWaiter returns nil, since fd cannot be polled here; keyReader
+// falls back to reading in the background instead.
+func inputWaiter(int) func(timeout time.Duration) (bool, error) {
+	return nil
+}
+
+// restoreOutputProcessing is a no-op where raw mode is not supported.
+func restoreOutputProcessing(int) {}
@@ -0,0 +1,90 @@
+package asky
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lockedBuffer is a bytes.Buffer safe for the render loop and the test to
+// share.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// fakeTerminal points output at a buffer, reports stdout as an 80x24
+// terminal and feeds prompts and abort watches from the returned writer
+// instead of stdin.
+func fakeTerminal(t *testing.T) (*lockedBuffer, *io.PipeWriter) {
+	t.Helper()
+	pr, pw := io.Pipe()
+	out := &lockedBuffer{}
+	oldOutput, oldIsTerminal, oldSize := stdOutput, stdoutIsTerminal, termSize
+	oldOpenKeys, oldOpenWatch := openKeyReader, openWatchReader
+	stdOutput = out
+	stdoutIsTerminal = func() bool { return true }
+	termSize = func() (int, int, error) { return 80, 24, nil }
+	open := func() (*keyReader, error) {
+		return &keyReader{r: bufio.NewReader(pr)}, nil
+	}
+	openKeyReader, openWatchReader = open, open
+	t.Cleanup(func() {
+		pw.Close()
+		stdOutput, stdoutIsTerminal, termSize = oldOutput, oldIsTerminal, oldSize
+		openKeyReader, openWatchReader = oldOpenKeys, oldOpenWatch
+	})
+	return out, pw
+}
+
+func TestAbortKeyStops(t *testing.T) {
+	quit := Key{Code: KeyRune, Rune: 'q'}
+	tests := []struct {
+		name  string
+		start func(onAbort func()) (stop func())
+	}{
+		{"spinner", func(onAbort func()) func() {
+			sp := Spinner().WithSignalHandling(false).WithAbortKey(quit).OnAbort(onAbort)
+			sp.Start()
+			return sp.Stop
+		}},
+		{"progress", func(onAbort func()) func() {
+			pr := Progress().WithTotal(10).WithSignalHandling(false).WithAbortKey(quit).OnAbort(onAbort)
+			pr.Start()
+			return pr.Stop
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, keys := fakeTerminal(t)
+
+			// Queued before Start, so the abort races the rest of the setup
+			go keys.Write([]byte("xq"))
+			aborted := make(chan struct{})
+			stop := tt.start(func() { close(aborted) })
+
+			select {
+			case <-aborted:
+			case <-time.After(2 * time.Second):
+				t.Fatal("abort key did not stop the component")
+			}
+			// A Stop after the abort is a no-op
+			stop()
+		})
+	}
+}
@@ -0,0 +1,36 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+
+package asky
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// inputWaiter returns a function reporting whether fd has input to read,
+// waiting at most timeout for some to arrive.
+func inputWaiter(fd int) func(timeout time.Duration) (bool, error) {
+	return func(timeout time.Duration) (bool, error) {
+		fds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+		for {
+			n, err := unix.Poll(fds, int(timeout.Milliseconds()))
+			if err == unix.EINTR {
+				continue
+			}
+			return n > 0, err
+		}
+	}
+}
+
+// restoreOutputProcessing turns output post-processing back on for the
+// terminal on fd after raw mode disabled it, so "\n" written by other
+// code still returns the cursor to the first column.
+func restoreOutputProcessing(fd int) {
+	t, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	if err != nil {
+		return
+	}
+	t.Oflag |= unix.OPOST
+	unix.IoctlSetTermios(fd, ioctlWriteTermios, t) //nolint:errcheck
+}
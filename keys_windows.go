@@ -0,0 +1,88 @@
+//go:build windows
+
+package asky
+
+import (
+	"encoding/binary"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	procPeekConsoleInputW = windows.NewLazySystemDLL("kernel32.dll").NewProc("PeekConsoleInputW")
+	procReadConsoleInputW = windows.NewLazySystemDLL("kernel32.dll").NewProc("ReadConsoleInputW")
+)
+
+// inputRecord mirrors the Win32 INPUT_RECORD structure. For a KEY_EVENT,
+// event holds a KEY_EVENT_RECORD: bKeyDown in bytes 0-3 and the UTF-16
+// character in bytes 10-11.
+type inputRecord struct {
+	eventType uint16
+	_         uint16
+	event     [16]byte
+}
+
+// inputWaiter returns a function reporting whether the console handle fd
+// has a character to read, waiting at most timeout for one to arrive.
+// The handle is also signalled by key releases, focus, mouse and resize
+// events, which reading the console skips, so only a key press carrying
+// a character counts as input.
+func inputWaiter(fd int) func(timeout time.Duration) (bool, error) {
+	h := windows.Handle(fd)
+	return func(timeout time.Duration) (bool, error) {
+		deadline := time.Now().Add(timeout)
+		for {
+			if ok, err := consoleHasChar(h); err != nil || ok {
+				return ok, err
+			}
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return false, nil
+			}
+			ev, err := windows.WaitForSingleObject(h, uint32(remaining.Milliseconds()))
+			if err != nil {
+				return false, err
+			}
+			if ev != windows.WAIT_OBJECT_0 {
+				return false, nil
+			}
+		}
+	}
+}
+
+// consoleHasChar reports whether a key press carrying a character is
+// queued on h. Records ahead of it, which reading the console would skip
+// anyway, are discarded so they stop signalling the handle.
+func consoleHasChar(h windows.Handle) (bool, error) {
+	var n uint32
+	if err := windows.GetNumberOfConsoleInputEvents(h, &n); err != nil || n == 0 {
+		return false, err
+	}
+	records := make([]inputRecord, n)
+	var got uint32
+	if r, _, err := procPeekConsoleInputW.Call(uintptr(h), uintptr(unsafe.Pointer(&records[0])), uintptr(n), uintptr(unsafe.Pointer(&got))); r == 0 {
+		return false, err
+	}
+	skip := got
+	for i, rec := range records[:got] {
+		if rec.eventType == windows.KEY_EVENT &&
+			binary.LittleEndian.Uint32(rec.event[0:4]) != 0 &&
+			binary.LittleEndian.Uint16(rec.event[10:12]) != 0 {
+			skip = uint32(i)
+			break
+		}
+	}
+	if skip > 0 {
+		var read uint32
+		if r, _, err := procReadConsoleInputW.Call(uintptr(h), uintptr(unsafe.Pointer(&records[0])), uintptr(skip), uintptr(unsafe.Pointer(&read))); r == 0 {
+			return false, err
+		}
+	}
+	return skip < got, nil
+}
+
+// restoreOutputProcessing is a no-op: raw mode on Windows only changes
+// the console input mode.
+func restoreOutputProcessing(int) {}
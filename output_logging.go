@@ -1,14 +1,20 @@
 package asky
 
-import "github.com/fatih/color"
+import (
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-runewidth"
+)
 
 // ==== Log Message ============================================================
 
 // log prints a single styled log line with a level prefix.
 // Construct one with [Log].
 type log struct {
-	cfg    Config
-	prefix string
+	cfg         Config
+	prefix      string
+	prefixStyle *color.Color
 }
 
 // Log returns a builder for printing a single styled log line.
@@ -31,6 +37,24 @@ func (l *log) WithPrefix(p string) *log {
 	return l
 }
 
+// WithIconFallback sets the prefix to emoji, or to ascii on terminals
+// and locales where emoji are unlikely to render correctly.
+//
+//	asky.Log().WithIconFallback("✅", "[ok]").Success("build passed")
+func (l *log) WithIconFallback(emoji, ascii string) *log {
+	l.prefix = iconFor(emoji, ascii)
+	return l
+}
+
+// WithPrefixStyle overrides the prefix color independently of the level.
+// The label keeps the level's style.
+//
+//	asky.Log().WithPrefix("[deploy]").WithPrefixStyle(color.New(color.FgMagenta)).Info("rolling out")
+func (l *log) WithPrefixStyle(c *color.Color) *log {
+	l.prefixStyle = c
+	return l
+}
+
 // Success prints a success message.
 func (l *log) Success(msg string) {
 	l.render(l.cfg.Styles.LogSuccessPrefix, l.cfg.Styles.LogSuccessLabel, "(✓)", msg)
@@ -57,9 +81,39 @@ func (l *log) Error(msg string) {
 }
 
 func (l *log) render(pfxStyle, labelStyle *color.Color, defaultPfx, msg string) {
-	pfx := safeStyle(pfxStyle).Sprint(pick(l.prefix, defaultPfx))
-	label := safeStyle(labelStyle).Sprint(msg)
-	stdOutput.Write([]byte(pfx + " " + label + "\n"))
+	if l.prefixStyle != nil {
+		pfxStyle = l.prefixStyle
+	}
+	pfxText := pick(l.prefix, defaultPfx)
+	pfx := safeStyle(pfxStyle).Sprint(pfxText)
+	indent := displayWidth(pfxText) + 1
+
+	// Continuation lines hang under the label, not the prefix
+	var b strings.Builder
+	for i, line := range wrapLogLabel(msg, indent) {
+		if i == 0 {
+			b.WriteString(pfx + " ")
+		} else {
+			b.WriteString(strings.Repeat(" ", indent))
+		}
+		b.WriteString(safeStyle(labelStyle).Sprint(line) + "\n")
+	}
+	stdOutput.Write([]byte(b.String()))
+}
+
+// wrapLogLabel wraps msg to the terminal columns left after indent.
+// The message is returned as-is when output is not a terminal (e.g.
+// piped, or redirected with [SetOutput]), or when the terminal is too
+// narrow to wrap sensibly.
+func wrapLogLabel(msg string, indent int) []string {
+	if !outputIsTerminal() {
+		return []string{msg}
+	}
+	termW, _, err := termSize()
+	if err != nil || termW-1-indent < 10 {
+		return []string{msg}
+	}
+	return wrapToWidth(msg, termW-1-indent)
 }
 
 // ==== Log Group ==============================================================
@@ -67,8 +121,9 @@ func (l *log) render(pfxStyle, labelStyle *color.Color, defaultPfx, msg string)
 // logGroup prints a styled title line followed by indented message lines.
 // Construct one with [LogGroup].
 type logGroup struct {
-	cfg    Config
-	prefix string
+	cfg         Config
+	prefix      string
+	prefixStyle *color.Color
 }
 
 // LogGroup returns a builder for printing a styled title with indented body lines.
@@ -91,6 +146,20 @@ func (l *logGroup) WithPrefix(p string) *logGroup {
 	return l
 }
 
+// WithIconFallback sets the prefix to emoji, or to ascii on terminals
+// and locales where emoji are unlikely to render correctly.
+func (l *logGroup) WithIconFallback(emoji, ascii string) *logGroup {
+	l.prefix = iconFor(emoji, ascii)
+	return l
+}
+
+// WithPrefixStyle overrides the prefix color independently of the level.
+// The title keeps the level's style.
+func (l *logGroup) WithPrefixStyle(c *color.Color) *logGroup {
+	l.prefixStyle = c
+	return l
+}
+
 // Success prints a success group.
 func (l *logGroup) Success(title string, msgs ...string) {
 	l.render(l.cfg.Styles.LogSuccessPrefix, l.cfg.Styles.LogSuccessLabel, "SUCCESS:", title, msgs...)
@@ -117,10 +186,120 @@ func (l *logGroup) Error(title string, msgs ...string) {
 }
 
 func (l *logGroup) render(pfxStyle, labelStyle *color.Color, defaultPfx, title string, msgs ...string) {
-	pfx := safeStyle(pfxStyle).Sprint(pick(l.prefix, defaultPfx))
-	titleStr := safeStyle(labelStyle).Sprint(title)
-	stdOutput.Write([]byte(pfx + " " + titleStr + "\n"))
+	if l.prefixStyle != nil {
+		pfxStyle = l.prefixStyle
+	}
+	pfxText := pick(l.prefix, defaultPfx)
+	pfx := safeStyle(pfxStyle).Sprint(pfxText)
+	indent := displayWidth(pfxText) + 1
+
+	var b strings.Builder
+	for i, line := range wrapLogLabel(title, indent) {
+		if i == 0 {
+			b.WriteString(pfx + " ")
+		} else {
+			b.WriteString(strings.Repeat(" ", indent))
+		}
+		b.WriteString(safeStyle(labelStyle).Sprint(line) + "\n")
+	}
 	for _, msg := range msgs {
-		stdOutput.Write([]byte("  " + safeStyle(l.cfg.Styles.LogGroupBody).Sprint(msg) + "\n"))
+		for _, line := range wrapLogLabel(msg, 2) {
+			b.WriteString("  " + safeStyle(l.cfg.Styles.LogGroupBody).Sprint(line) + "\n")
+		}
+	}
+	stdOutput.Write([]byte(b.String()))
+}
+
+// ==== Log Table ==============================================================
+
+// logTable collects key/value log rows and prints them with aligned columns.
+// Construct one with [LogTable].
+type logTable struct {
+	cfg  Config
+	rows []logTableRow
+}
+
+// logTableRow is a single buffered row of a [logTable].
+type logTableRow struct {
+	pfxStyle   *color.Color
+	labelStyle *color.Color
+	pfx        string
+	key        string
+	value      string
+}
+
+// LogTable returns a builder for printing key/value rows with their
+// separators aligned. Rows are buffered until [logTable.Render] is called.
+//
+//	asky.LogTable().
+//		Success("api", "running").
+//		Warn("worker", "degraded").
+//		Error("scheduler", "stopped").
+//		Render()
+func LogTable() *logTable {
+	return &logTable{cfg: pkgConfig}
+}
+
+// WithStyles overrides the [StyleMap] for this table.
+func (t *logTable) WithStyles(s *StyleMap) *logTable {
+	t.cfg.Styles = s
+	return t
+}
+
+// Success adds a success row.
+func (t *logTable) Success(key, value string) *logTable {
+	return t.add(t.cfg.Styles.LogSuccessPrefix, t.cfg.Styles.LogSuccessLabel, "(✓)", key, value)
+}
+
+// Debug adds a debug row.
+func (t *logTable) Debug(key, value string) *logTable {
+	return t.add(t.cfg.Styles.LogDebugPrefix, t.cfg.Styles.LogDebugLabel, "(~)", key, value)
+}
+
+// Info adds an info row.
+func (t *logTable) Info(key, value string) *logTable {
+	return t.add(t.cfg.Styles.LogInfoPrefix, t.cfg.Styles.LogInfoLabel, "(i)", key, value)
+}
+
+// Warn adds a warning row.
+func (t *logTable) Warn(key, value string) *logTable {
+	return t.add(t.cfg.Styles.LogWarnPrefix, t.cfg.Styles.LogWarnLabel, "(!)", key, value)
+}
+
+// Error adds an error row.
+func (t *logTable) Error(key, value string) *logTable {
+	return t.add(t.cfg.Styles.LogErrorPrefix, t.cfg.Styles.LogErrorLabel, "(✗)", key, value)
+}
+
+func (t *logTable) add(pfxStyle, labelStyle *color.Color, pfx, key, value string) *logTable {
+	t.rows = append(t.rows, logTableRow{
+		pfxStyle:   pfxStyle,
+		labelStyle: labelStyle,
+		pfx:        pfx,
+		key:        key,
+		value:      value,
+	})
+	return t
+}
+
+// Render prints all buffered rows, padding keys so the separators and
+// values line up. The buffer is cleared afterwards.
+func (t *logTable) Render() {
+	if len(t.rows) == 0 {
+		return
+	}
+
+	keyW := 0
+	for _, r := range t.rows {
+		keyW = max(keyW, runewidth.StringWidth(r.key))
+	}
+
+	var b strings.Builder
+	for _, r := range t.rows {
+		key := r.key + strings.Repeat(" ", keyW-runewidth.StringWidth(r.key))
+		b.WriteString(safeStyle(r.pfxStyle).Sprint(r.pfx) + " ")
+		b.WriteString(safeStyle(r.labelStyle).Sprint(key+" : "+r.value) + "\n")
 	}
+	stdOutput.Write([]byte(b.String()))
+	t.rows = nil
 }
@@ -1,11 +1,13 @@
 package asky
 
 import (
+	"math"
 	"os"
 	"os/signal"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -26,6 +28,8 @@ var (
 )
 
 // ProgressPattern defines the characters used to render the progress bar.
+// DoneChar and PendingChar may be wide, such as emoji; they are repeated by
+// display width, and any column left over is filled with a space.
 type ProgressPattern struct {
 	DoneChar    string
 	PendingChar string
@@ -39,11 +43,25 @@ type progress struct {
 	cfg            Config
 	prefix         string
 	label          string
+	labelFn        func() string
 	total          int
 	current        int
 	width          int
 	pattern        ProgressPattern
-	stop           bool
+	frames         []string
+	frameIdx       int
+	logLines       int
+	logBuf         []string
+	percentRight   bool
+	precision      int
+	showSteps      bool
+	static         bool
+	plain          bool
+	abortKeys      []Key
+	onAbort        func()
+	stopKeys       func()
+	stop           atomic.Bool
+	sigCh          chan os.Signal
 	wg             sync.WaitGroup
 	mu             sync.Mutex
 	lastCompletion int
@@ -88,13 +106,26 @@ func (pr *progress) WithLabel(label string) *progress {
 	return pr
 }
 
+// WithLabelFunc computes the label on every redraw from fn, overriding the
+// static label and UpdateLabel. fn runs while the bar's lock is held, so it
+// must be cheap and must not call back into the progress bar.
+//
+//	pr.WithLabelFunc(func() string { return "copying " + current.Load().(string) })
+func (pr *progress) WithLabelFunc(fn func() string) *progress {
+	pr.labelFn = fn
+	return pr
+}
+
 // WithTotal sets the total number of steps for the progress bar.
 func (pr *progress) WithTotal(total int) *progress {
 	pr.total = max(1, total)
 	return pr
 }
 
-// WithWidth sets the maximum width of the bar in characters. Defaults to 40.
+// WithWidth sets the width of the bar in display columns, excluding the
+// pattern's pads. The bar is always exactly width columns, however short
+// the label, and only shrinks when the terminal is too narrow to fit it
+// beside the label and status. Defaults to 40.
 func (pr *progress) WithWidth(width int) *progress {
 	pr.width = max(1, width)
 	return pr
@@ -106,6 +137,87 @@ func (pr *progress) WithPattern(p ProgressPattern) *progress {
 	return pr
 }
 
+// WithPercentRightAligned pins the percentage to the right edge of the
+// terminal and stretches the bar to fill the space before it, ignoring
+// WithWidth. Useful for lining up several bars printed one after another.
+func (pr *progress) WithPercentRightAligned() *progress {
+	pr.percentRight = true
+	return pr
+}
+
+// WithPercentPrecision shows the percentage with n decimals, e.g. "42.5%"
+// for n = 1. Values are rounded down, so 100% only appears once complete.
+func (pr *progress) WithPercentPrecision(n int) *progress {
+	pr.precision = max(0, n)
+	return pr
+}
+
+// WithStepCounts shows the completed and total steps, e.g. "123/1,000",
+// in place of the percentage. Accessible and static modes still print
+// percentages.
+func (pr *progress) WithStepCounts() *progress {
+	pr.showSteps = true
+	return pr
+}
+
+// WithSpinner animates the prefix with frames, advancing one frame per
+// render tick independently of Increment. Any of the Spinner* presets can
+// be used. Accessible and static modes keep the static prefix.
+//
+//	asky.Progress().WithSpinner(asky.SpinnerDotsMini).WithTotal(len(files))
+func (pr *progress) WithSpinner(frames []string) *progress {
+	pr.frames = frames
+	return pr
+}
+
+// WithLogLines reserves up to k lines above the bar for the most recent
+// messages passed to Log, turning the bar into a compact task monitor.
+// Older lines scroll out; the region is cleared along with the bar.
+func (pr *progress) WithLogLines(k int) *progress {
+	pr.logLines = max(0, k)
+	return pr
+}
+
+// WithAbortKey lets the user stop the progress bar by pressing one of
+// keys, such as q or Escape, without exiting the process. The bar is
+// stopped and the callback set with OnAbort runs, so the caller can cancel
+// its work. Ctrl+C keeps its usual meaning. Ignored in accessible mode or
+// when stdin is not a terminal.
+func (pr *progress) WithAbortKey(keys ...Key) *progress {
+	pr.abortKeys = keys
+	return pr
+}
+
+// OnAbort sets the callback run after an abort key stops the progress bar.
+func (pr *progress) OnAbort(fn func()) *progress {
+	pr.onAbort = fn
+	return pr
+}
+
+// WithReducedMotion keeps the prefix static even when WithSpinner is set.
+// See [Config.ReducedMotion].
+func (pr *progress) WithReducedMotion() *progress {
+	pr.cfg.ReducedMotion = true
+	return pr
+}
+
+// WithStaticRender prints a newline-terminated line at every 10% step
+// instead of redrawing the bar in place, as in accessible mode. This is the
+// default when stdout is not a terminal, so CI logs stay readable.
+func (pr *progress) WithStaticRender() *progress {
+	pr.static = true
+	return pr
+}
+
+// WithSignalHandling controls whether the progress bar installs its own
+// SIGINT/SIGTERM handler, which restores the terminal and exits the process.
+// Enabled by default unless [Config.NoSignalHandling] is set. Disable it when
+// the host program handles signals and calls Stop itself.
+func (pr *progress) WithSignalHandling(enabled bool) *progress {
+	pr.cfg.NoSignalHandling = !enabled
+	return pr
+}
+
 // UpdateLabel changes the progress bar label while it is running.
 // Safe to call from any goroutine.
 //
@@ -113,36 +225,64 @@ func (pr *progress) WithPattern(p ProgressPattern) *progress {
 func (pr *progress) UpdateLabel(label string) {
 	pr.mu.Lock()
 	pr.label = label
+	plain := pr.plain
 	pr.mu.Unlock()
 
-	if pr.cfg.Accessible {
+	if plain {
 		stdOutput.Write([]byte(
 			safeStyle(pr.cfg.Styles.ProgressPrefix).Sprint(pr.prefix) + " " +
 				safeStyle(pr.cfg.Styles.ProgressLabel).Sprint(label) + "\n"))
 	}
 }
 
+// Log adds line to the log region set up with WithLogLines, dropping the
+// oldest line once k lines are shown. Lines are truncated to the terminal
+// width. In accessible or static mode each line is printed as it arrives.
+// Safe to call from any goroutine.
+//
+//	pb.Log("fetched " + url)
+func (pr *progress) Log(line string) {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+	if pr.plain {
+		stdOutput.Write([]byte(line + "\n"))
+		return
+	}
+	if pr.logLines == 0 {
+		return
+	}
+	pr.logBuf = append(pr.logBuf, strings.ReplaceAll(line, "\n", " "))
+	if len(pr.logBuf) > pr.logLines {
+		pr.logBuf = pr.logBuf[len(pr.logBuf)-pr.logLines:]
+	}
+}
+
 // Start begins the progress bar render loop in a background goroutine.
 // The bar cleans up automatically when the total is reached.
-// In accessible mode, prints milestone lines instead of animating.
+// In accessible or static mode, prints milestone lines instead of animating.
 func (pr *progress) Start() {
-	if !pr.cfg.Accessible {
+	pr.mu.Lock()
+	pr.plain = pr.cfg.Accessible || pr.static || !outputIsTerminal()
+	pr.mu.Unlock()
+	if !pr.plain {
 		stdOutput.Write([]byte(ansiHideCursor))
 	}
 
 	// Watch for Ctrl+C: restore terminal before exit
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
-	go func() {
-		<-sigCh
-		pr.stop = true
-		pr.wg.Wait()
-		os.Exit(1)
-	}()
+	if !pr.cfg.NoSignalHandling {
+		pr.sigCh = make(chan os.Signal, 1)
+		signal.Notify(pr.sigCh, os.Interrupt, syscall.SIGTERM)
+		go func(ch <-chan os.Signal) {
+			if _, ok := <-ch; ok {
+				pr.Stop()
+				os.Exit(1)
+			}
+		}(pr.sigCh)
+	}
 
 	pr.wg.Go(func() {
-		if pr.cfg.Accessible {
-			for !pr.stop {
+		if pr.plain {
+			for !pr.stop.Load() {
 				pr.redraw()
 				time.Sleep(100 * time.Millisecond)
 			}
@@ -157,12 +297,30 @@ func (pr *progress) Start() {
 			stdOutput.Write([]byte("\r" + ansiClearScreen + ansiShowCursor))
 		}()
 
-		for !pr.stop {
+		// The bar is cleared on exit, so there is no final frame to draw;
+		// a Stop before the first frame leaves nothing behind
+		for !pr.stop.Load() {
 			pr.redraw()
 			time.Sleep(100 * time.Millisecond)
 		}
-		pr.redraw()
 	})
+
+	// Listen for abort keys; raw mode swallows Ctrl+C, so honour it here.
+	// Started after the render loop so an early abort's Stop waits on it;
+	// stopKeys is set under mu, which Stop also holds to read it
+	if len(pr.abortKeys) > 0 && !pr.plain {
+		pr.mu.Lock()
+		pr.stopKeys, _ = watchKeys(pr.abortKeys, func(ev Key) {
+			pr.Stop()
+			if ev.Code == KeyCtrlC && !pr.cfg.NoSignalHandling {
+				os.Exit(1)
+			}
+			if pr.onAbort != nil {
+				pr.onAbort()
+			}
+		})
+		pr.mu.Unlock()
+	}
 }
 
 // Increment advances the progress bar by one step.
@@ -176,8 +334,7 @@ func (pr *progress) Increment() {
 	pr.mu.Unlock()
 
 	if done {
-		pr.stop = true
-		pr.wg.Wait()
+		pr.Stop()
 	}
 }
 
@@ -190,42 +347,93 @@ func (pr *progress) Set(n int) {
 	pr.mu.Unlock()
 
 	if done {
-		pr.stop = true
-		pr.wg.Wait()
+		pr.Stop()
 	}
 }
 
-// redraw renders the current progress bar state to the terminal.
-func (pr *progress) redraw() {
+// Consume calls Set with each value received from ch until ch is closed,
+// then stops the bar. It blocks, so run it on the goroutine that waits for
+// the work, or in its own goroutine. Values are absolute step counts.
+//
+//	steps := make(chan int)
+//	go produce(steps) // sends 1, 2, 3... and closes steps
+//	pr := asky.Progress().WithTotal(n)
+//	pr.Start()
+//	pr.Consume(steps)
+func (pr *progress) Consume(ch <-chan int) {
+	for n := range ch {
+		pr.Set(n)
+	}
+	pr.Stop()
+}
+
+// Current returns the number of steps completed so far.
+// Safe to call from any goroutine.
+func (pr *progress) Current() int {
 	pr.mu.Lock()
 	defer pr.mu.Unlock()
+	return pr.current
+}
 
-	// Clamp ratio between 0 and 1
-	ratio := float64(pr.current) / float64(pr.total)
-	ratio = min(max(ratio, 0), 1)
+// Total returns the total number of steps set with WithTotal.
+// Safe to call from any goroutine.
+func (pr *progress) Total() int {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+	return pr.total
+}
 
-	// Format percentage padded to 4 chars
-	percent := strconv.Itoa(int(ratio * 100))
-	for runewidth.StringWidth(percent) < 4 {
-		percent = " " + percent
+// Stop halts the progress bar and clears the bar line, even if the total
+// has not been reached. Called automatically on completion; safe to call
+// multiple times.
+func (pr *progress) Stop() {
+	if pr.stop.Swap(true) {
+		return
+	}
+	pr.wg.Wait()
+	pr.mu.Lock()
+	stopKeys := pr.stopKeys
+	pr.mu.Unlock()
+	if stopKeys != nil {
+		stopKeys()
+	}
+	pr.mu.Lock()
+	if pr.sigCh != nil {
+		signal.Stop(pr.sigCh)
+		close(pr.sigCh)
+		pr.sigCh = nil
 	}
-	percent += "%"
+	pr.mu.Unlock()
+}
+
+// RenderStatic returns the bar as it would be drawn at current out of
+// total steps, using the configured label, pattern, width and styles but
+// without starting the render loop. Useful for reports and log files.
+//
+//	fmt.Println(asky.Progress().WithLabel("disk").RenderStatic(42, 100))
+func (pr *progress) RenderStatic(current, total int) string {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
 
-	// Determine available width for the bar
 	termWidth, _, _ := termSize()
 	if termWidth <= 0 {
 		termWidth = 80
 	}
-	fixedWidth := runewidth.StringWidth(pr.prefix + " " + pr.label + " " + pr.pattern.PadLeft + pr.pattern.PadRight + "  " + percent)
-	availWidth := max(termWidth-fixedWidth, 0)
-	barWidth := min(availWidth, pr.width)
+	return pr.barLine(current, max(1, total), pr.prefix, termWidth)
+}
 
-	// Calculate filled and pending segments
-	filled := min(int(ratio*float64(barWidth)), barWidth)
-	pending := barWidth - filled
+// redraw renders the current progress bar state to the terminal.
+func (pr *progress) redraw() {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	if pr.labelFn != nil {
+		pr.label = pr.labelFn()
+	}
 
-	// Accessible mode: print milestone lines
-	if pr.cfg.Accessible {
+	// Accessible or static mode: print milestone lines
+	if pr.plain {
+		ratio := min(max(float64(pr.current)/float64(pr.total), 0), 1)
 		milestone := int(ratio * 10) // 0-10
 		for pr.lastCompletion < milestone {
 			pr.lastCompletion++
@@ -238,24 +446,96 @@ func (pr *progress) redraw() {
 		return
 	}
 
-	// Build styled bar
-	bar := safeStyle(pr.cfg.Styles.ProgressBarPad).Sprint(pr.pattern.PadLeft) +
-		safeStyle(pr.cfg.Styles.ProgressBarDone).Sprint(strings.Repeat(pr.pattern.DoneChar, filled)) +
-		safeStyle(pr.cfg.Styles.ProgressBarPending).Sprint(strings.Repeat(pr.pattern.PendingChar, pending)) +
-		safeStyle(pr.cfg.Styles.ProgressBarPad).Sprint(pr.pattern.PadRight)
+	// Advance the spinner frame, if any, on every tick
+	prefix := pr.prefix
+	if len(pr.frames) > 0 && !pr.cfg.ReducedMotion {
+		prefix = pr.frames[pr.frameIdx%len(pr.frames)]
+		pr.frameIdx++
+	}
 
-	line := safeStyle(pr.cfg.Styles.ProgressPrefix).Sprint(pr.prefix) + " " +
-		safeStyle(pr.cfg.Styles.ProgressLabel).Sprint(pr.label) + " " +
-		bar +
-		safeStyle(pr.cfg.Styles.ProgressBarStatus).Sprint(percent)
+	termWidth, _, _ := termSize()
+	if termWidth <= 0 {
+		termWidth = 80
+	}
+	line := pr.barLine(pr.current, pr.total, prefix, termWidth)
+
+	// Prepend the log region, one truncated row per line. Rows end in \r\n
+	// since an abort key listener may have put the terminal in raw mode.
+	var logRows strings.Builder
+	for _, l := range pr.logBuf {
+		logRows.WriteString(safeStyle(pr.cfg.Styles.ProgressBarPending).Sprint(TruncToWidth(l, termWidth-1)) + "\r\n")
+	}
 
-	newHeight := physicalLines(stripAnsi(line), termWidth)
+	newHeight := len(pr.logBuf) + physicalLines(stripAnsi(line), termWidth)
 
 	// Move to top of previous frame
 	if pr.lineHeight > 1 {
 		ansiCursorUp(pr.lineHeight - 1)
 	}
-	stdOutput.Write([]byte("\r" + ansiClearScreen + line))
+	stdOutput.Write([]byte("\r" + ansiClearScreen + logRows.String() + line))
 
 	pr.lineHeight = newHeight
 }
+
+// barLine builds the styled bar line for current out of total steps,
+// fitted to termWidth columns.
+func (pr *progress) barLine(current, total int, prefix string, termWidth int) string {
+	// Clamp ratio between 0 and 1
+	ratio := float64(current) / float64(total)
+	ratio = min(max(ratio, 0), 1)
+
+	// Format the status: step counts, or a percentage padded to 4 chars
+	// plus any decimals, rounded down so it never shows 100% early
+	var percent string
+	if pr.showSteps {
+		totalStr := groupThousands(total)
+		percent = groupThousands(current)
+		for runewidth.StringWidth(percent) < runewidth.StringWidth(totalStr)+1 {
+			percent = " " + percent
+		}
+		percent += "/" + totalStr
+	} else {
+		scale := math.Pow10(pr.precision)
+		percent = strconv.FormatFloat(math.Floor(ratio*100*scale)/scale, 'f', pr.precision, 64)
+		padTo := 4
+		if pr.precision > 0 {
+			padTo += pr.precision + 1
+		}
+		for runewidth.StringWidth(percent) < padTo {
+			percent = " " + percent
+		}
+		percent += "%"
+	}
+
+	// Determine available width for the bar
+	fixedWidth := runewidth.StringWidth(prefix + " " + pr.label + " " + pr.pattern.PadLeft + pr.pattern.PadRight + "  " + percent)
+	availWidth := max(termWidth-fixedWidth, 0)
+	barWidth := min(availWidth, pr.width)
+	if pr.percentRight && availWidth > 0 {
+		// Fill up to the last column but one, so the line never wraps
+		barWidth = availWidth + 1
+	}
+
+	// Calculate filled and pending segments; the ends are exact so a
+	// finished bar is always full and a fresh one always empty
+	filled := min(int(ratio*float64(barWidth)), barWidth)
+	switch {
+	case current >= total:
+		filled = barWidth
+	case current <= 0:
+		filled = 0
+	}
+	pending := barWidth - filled
+
+	// Build styled bar; filled and pending are display columns, so wide
+	// pattern characters are repeated to fit rather than by count
+	bar := safeStyle(pr.cfg.Styles.ProgressBarPad).Sprint(pr.pattern.PadLeft) +
+		safeStyle(pr.cfg.Styles.ProgressBarDone).Sprint(repeatToWidth(pr.pattern.DoneChar, filled)) +
+		safeStyle(pr.cfg.Styles.ProgressBarPending).Sprint(repeatToWidth(pr.pattern.PendingChar, pending)) +
+		safeStyle(pr.cfg.Styles.ProgressBarPad).Sprint(pr.pattern.PadRight)
+
+	return safeStyle(pr.cfg.Styles.ProgressPrefix).Sprint(prefix) + " " +
+		safeStyle(pr.cfg.Styles.ProgressLabel).Sprint(pr.label) + " " +
+		bar +
+		safeStyle(pr.cfg.Styles.ProgressBarStatus).Sprint(percent)
+}
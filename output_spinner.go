@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/signal"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 )
@@ -26,16 +27,40 @@ var (
 	SpinnerBall     = []string{"( ●    )", "(  ●   )", "(   ●  )", "(    ● )", "(     ●)", "(    ● )", "(   ●  )", "(  ●   )", "( ●    )", "(●     )"}
 )
 
+// Pure-ASCII spinner presets, for terminals, fonts and CI logs that render
+// Braille and box-drawing characters poorly.
+var (
+	SpinnerASCIIArrow  = []string{"<", "^", ">", "v"}
+	SpinnerASCIIBounce = []string{"[.   ]", "[ .  ]", "[  . ]", "[   .]", "[  . ]", "[ .  ]"}
+	SpinnerASCIIClock  = []string{"(|)", "(/)", "(-)", "(\\)"}
+	SpinnerASCIIDots   = []string{".  ", ".. ", "...", " ..", "  .", "   "}
+)
+
+// reducedMotionFrame is the static frame shown in place of an animation
+// when [Config.ReducedMotion] is set.
+const reducedMotionFrame = "[…]"
+
 // spinner renders an animated spinner on a single line.
 // Construct one with [Spinner].
 type spinner struct {
-	cfg      Config
-	frames   []string
-	label    string
-	interval time.Duration
-	stop     bool
-	mu       sync.Mutex
-	wg       sync.WaitGroup
+	cfg       Config
+	frames    []string
+	patterns  [][]string
+	every     time.Duration
+	doneFrame string
+	label     string
+	labelFn   func() string
+	interval  time.Duration
+	started   atomic.Bool
+	stop      atomic.Bool
+	sigCh     chan os.Signal
+	mu        sync.Mutex
+	wg        sync.WaitGroup
+	abortKeys []Key
+	onAbort   func()
+	stopKeys  func()
+	static    bool
+	plain     bool
 }
 
 // Spinner returns a spinner builder with sensible defaults.
@@ -67,18 +92,101 @@ func (sp *spinner) WithFrames(frames []string) *spinner {
 	return sp
 }
 
+// WithCyclePatterns switches the animation to the next of patterns every
+// interval, starting with the first and wrapping around after the last.
+// Empty patterns are skipped; with none left, or a non-positive every, the
+// frames set with WithFrames are used as usual.
+//
+//	sp.WithCyclePatterns([][]string{asky.SpinnerDots, asky.SpinnerMoons}, 2*time.Second)
+func (sp *spinner) WithCyclePatterns(patterns [][]string, every time.Duration) *spinner {
+	sp.patterns = nil
+	if every <= 0 {
+		return sp
+	}
+	for _, p := range patterns {
+		if len(p) > 0 {
+			sp.patterns = append(sp.patterns, p)
+		}
+	}
+	sp.every = every
+	return sp
+}
+
+// WithCompletionFrame makes Stop leave frame and the current label on the
+// spinner line, e.g. "✓", instead of clearing it. Without a terminal, the
+// completion line uses frame in place of the "done" suffix.
+//
+//	sp.WithCompletionFrame("✓")
+func (sp *spinner) WithCompletionFrame(frame string) *spinner {
+	sp.doneFrame = frame
+	return sp
+}
+
 // WithLabel sets the label displayed beside the spinner frame.
 func (sp *spinner) WithLabel(label string) *spinner {
 	sp.label = label
 	return sp
 }
 
+// WithLabelFunc computes the label on every frame from fn, overriding the
+// static label and UpdateLabel. fn runs on the animation goroutine while
+// the spinner's lock is held, so it must be cheap and must not call back
+// into the spinner. With reduced motion, an unchanged result is not redrawn.
+//
+//	sp.WithLabelFunc(func() string { return fmt.Sprintf("%d files", done.Load()) })
+func (sp *spinner) WithLabelFunc(fn func() string) *spinner {
+	sp.labelFn = fn
+	return sp
+}
+
 // WithInterval sets the frame animation interval. Defaults to 100ms.
 func (sp *spinner) WithInterval(d time.Duration) *spinner {
 	sp.interval = d
 	return sp
 }
 
+// WithReducedMotion shows a static "[…]" frame instead of animating, and
+// only redraws when the label changes. See [Config.ReducedMotion].
+func (sp *spinner) WithReducedMotion() *spinner {
+	sp.cfg.ReducedMotion = true
+	return sp
+}
+
+// WithStaticFrame prints the label once with a "..." suffix, and a single
+// completion line on Stop, instead of animating. This is the default when
+// stdout is not a terminal, so CI logs are not flooded with frames.
+func (sp *spinner) WithStaticFrame() *spinner {
+	sp.static = true
+	return sp
+}
+
+// WithSignalHandling controls whether the spinner installs its own
+// SIGINT/SIGTERM handler, which restores the terminal and exits the process.
+// Enabled by default unless [Config.NoSignalHandling] is set. Disable it when
+// the host program handles signals and calls Stop itself.
+func (sp *spinner) WithSignalHandling(enabled bool) *spinner {
+	sp.cfg.NoSignalHandling = !enabled
+	return sp
+}
+
+// WithAbortKey lets the user stop the spinner by pressing one of keys,
+// such as q or Escape, without exiting the process. The spinner is stopped
+// and the callback set with OnAbort runs, so the caller can cancel its work.
+// Ctrl+C keeps its usual meaning. Ignored in accessible mode or when stdin
+// is not a terminal.
+//
+//	sp.WithAbortKey(asky.Key{Code: asky.KeyRune, Rune: 'q'}, asky.Key{Code: asky.KeyEscape})
+func (sp *spinner) WithAbortKey(keys ...Key) *spinner {
+	sp.abortKeys = keys
+	return sp
+}
+
+// OnAbort sets the callback run after an abort key stops the spinner.
+func (sp *spinner) OnAbort(fn func()) *spinner {
+	sp.onAbort = fn
+	return sp
+}
+
 // UpdateLabel changes the spinner label while the animation is running.
 // Safe to call from any goroutine.
 //
@@ -88,33 +196,52 @@ func (sp *spinner) UpdateLabel(label string) {
 	sp.label = label
 	sp.mu.Unlock()
 
-	if sp.cfg.Accessible {
+	switch {
+	case sp.cfg.Accessible:
 		stdOutput.Write([]byte(sp.frames[0] + " " + label + "\n"))
+	case sp.plain:
+		stdOutput.Write([]byte(safeStyle(sp.cfg.Styles.SpinnerLabel).Sprint(label+"...") + "\n"))
 	}
 }
 
 // Start begins the spinner animation in a background goroutine.
 // In accessible mode, prints a single static line instead of animating.
+// Without a terminal, or with WithStaticFrame, prints the label once.
 func (sp *spinner) Start() {
 	if sp.cfg.Accessible {
 		stdOutput.Write([]byte(sp.frames[0] + " " + sp.label + "\n"))
 		return
 	}
+	if sp.static || !outputIsTerminal() {
+		sp.plain = true
+		sp.started.Store(true)
+		sp.mu.Lock()
+		label := sp.currentLabel()
+		sp.mu.Unlock()
+		stdOutput.Write([]byte(safeStyle(sp.cfg.Styles.SpinnerLabel).Sprint(label+"...") + "\n"))
+		return
+	}
 
+	sp.started.Store(true)
 	stdOutput.Write([]byte(ansiHideCursor))
 
 	// Watch for Ctrl+C & restore terminal before exit
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
-	go func() {
-		<-sigCh
-		sp.Stop()
-		os.Exit(1)
-	}()
+	if !sp.cfg.NoSignalHandling {
+		sp.sigCh = make(chan os.Signal, 1)
+		signal.Notify(sp.sigCh, os.Interrupt, syscall.SIGTERM)
+		go func(ch <-chan os.Signal) {
+			if _, ok := <-ch; ok {
+				sp.Stop()
+				os.Exit(1)
+			}
+		}(sp.sigCh)
+	}
 
 	sp.wg.Go(func() {
 		lineHeight := 0
 		i := 0
+		drawn := ""
+		startedAt := time.Now()
 
 		defer func() {
 			if lineHeight > 1 {
@@ -123,14 +250,28 @@ func (sp *spinner) Start() {
 			stdOutput.Write([]byte("\r" + ansiClearScreen + ansiShowCursor))
 		}()
 
-		for !sp.stop {
+		for !sp.stop.Load() {
 			sp.mu.Lock()
-			label := sp.label
+			label := sp.currentLabel()
 			sp.mu.Unlock()
 
-			frame := safeStyle(sp.cfg.Styles.SpinnerPrefix).Sprint(sp.frames[i%len(sp.frames)])
-			styledLabel := safeStyle(sp.cfg.Styles.SpinnerLabel).Sprint(label)
-			line := frame + " " + styledLabel
+			frames := sp.frames
+			if len(sp.patterns) > 0 {
+				frames = sp.patterns[int(time.Since(startedAt)/sp.every)%len(sp.patterns)]
+			}
+			frame := frames[i%len(frames)]
+			if sp.cfg.ReducedMotion {
+				frame = reducedMotionFrame
+			}
+			line := safeStyle(sp.cfg.Styles.SpinnerPrefix).Sprint(frame) + " " +
+				safeStyle(sp.cfg.Styles.SpinnerLabel).Sprint(label)
+
+			// Without motion, only a label change is worth a redraw
+			if sp.cfg.ReducedMotion && line == drawn {
+				time.Sleep(sp.interval)
+				continue
+			}
+			drawn = line
 
 			termW, _, _ := termSize()
 			newHeight := physicalLines(stripAnsi(line), termW)
@@ -146,14 +287,79 @@ func (sp *spinner) Start() {
 			time.Sleep(sp.interval)
 		}
 	})
+
+	// Listen for abort keys; raw mode swallows Ctrl+C, so honour it here.
+	// Started after the render loop so an early abort's Stop waits on it;
+	// stopKeys is set under mu, which Stop also holds to read it
+	if len(sp.abortKeys) > 0 {
+		sp.mu.Lock()
+		sp.stopKeys, _ = watchKeys(sp.abortKeys, func(ev Key) {
+			sp.Stop()
+			if ev.Code == KeyCtrlC && !sp.cfg.NoSignalHandling {
+				os.Exit(1)
+			}
+			if sp.onAbort != nil {
+				sp.onAbort()
+			}
+		})
+		sp.mu.Unlock()
+	}
 }
 
-// Stop halts the spinner and clears the spinner line.
-// Safe to call multiple times.
+// currentLabel returns the label to draw, refreshed from the label func
+// when one is set. The caller must hold sp.mu.
+func (sp *spinner) currentLabel() string {
+	if sp.labelFn != nil {
+		sp.label = sp.labelFn()
+	}
+	return sp.label
+}
+
+// Stop halts the spinner and clears the spinner line, or leaves the
+// completion frame set with WithCompletionFrame. When the spinner is not
+// animating it prints the completion line instead. Does nothing if the
+// spinner was never started; safe to call multiple times.
 func (sp *spinner) Stop() {
-	if sp.cfg.Accessible || sp.stop {
+	if sp.cfg.Accessible || !sp.started.Load() || sp.stop.Swap(true) {
+		return
+	}
+	if sp.plain {
+		sp.mu.Lock()
+		label := sp.currentLabel()
+		sp.mu.Unlock()
+		if sp.doneFrame != "" {
+			stdOutput.Write([]byte(sp.completionLine(label) + "\n"))
+			return
+		}
+		stdOutput.Write([]byte(safeStyle(sp.cfg.Styles.SpinnerLabel).Sprint(label+" done") + "\n"))
 		return
 	}
-	sp.stop = true
 	sp.wg.Wait()
+	sp.mu.Lock()
+	stopKeys := sp.stopKeys
+	sp.mu.Unlock()
+	if stopKeys != nil {
+		stopKeys()
+	}
+	// Written once the terminal has left raw mode, so "\n" starts a line
+	if sp.doneFrame != "" {
+		sp.mu.Lock()
+		label := sp.currentLabel()
+		sp.mu.Unlock()
+		stdOutput.Write([]byte(sp.completionLine(label) + "\n"))
+	}
+	sp.mu.Lock()
+	if sp.sigCh != nil {
+		signal.Stop(sp.sigCh)
+		close(sp.sigCh)
+		sp.sigCh = nil
+	}
+	sp.mu.Unlock()
+}
+
+// completionLine returns the styled line left behind by Stop when a
+// completion frame is set.
+func (sp *spinner) completionLine(label string) string {
+	return safeStyle(sp.cfg.Styles.SpinnerPrefix).Sprint(sp.doneFrame) + " " +
+		safeStyle(sp.cfg.Styles.SpinnerLabel).Sprint(label)
 }
@@ -0,0 +1,119 @@
+package asky
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+)
+
+func TestStopBeforeFirstFrameLeavesNoResidue(t *testing.T) {
+	tests := []struct {
+		name  string
+		start func() (stop func())
+	}{
+		{"spinner", func() func() {
+			sp := Spinner().WithSignalHandling(false)
+			sp.Start()
+			return sp.Stop
+		}},
+		{"progress", func() func() {
+			pr := Progress().WithTotal(10).WithSignalHandling(false)
+			pr.Start()
+			return pr.Stop
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, _ := fakeTerminal(t)
+
+			stop := tt.start()
+			time.Sleep(time.Millisecond)
+			stop()
+
+			// Any frame drawn in that millisecond is cleared again
+			got := out.String()
+			if strings.Contains(got, "\n") {
+				t.Errorf("output %q contains a newline", got)
+			}
+			if !strings.HasPrefix(got, ansiHideCursor) {
+				t.Errorf("output %q does not start by hiding the cursor", got)
+			}
+			if want := "\r" + ansiClearScreen + ansiShowCursor; !strings.HasSuffix(got, want) {
+				t.Errorf("output %q does not end with %q", got, want)
+			}
+		})
+	}
+}
+
+// barSegment returns the plain bar cells of a progress line drawn with
+// "[" and "]" as the pattern's pads.
+func barSegment(t *testing.T, line string) string {
+	t.Helper()
+	plain := stripAnsi(line)
+	start, end := strings.Index(plain, "["), strings.LastIndex(plain, "]")
+	if start < 0 || end < start {
+		t.Fatalf("no bar in %q", plain)
+	}
+	return plain[start+1 : end]
+}
+
+func TestProgressFullAtTotal(t *testing.T) {
+	pattern := ProgressPattern{DoneChar: "#", PendingChar: "-", PadLeft: "[", PadRight: "]"}
+	for _, width := range []int{1, 3, 7, 13, 29, 33} {
+		t.Run(fmt.Sprintf("width %d", width), func(t *testing.T) {
+			pr := Progress().WithPrefix("").WithLabel("").WithPattern(pattern).WithWidth(width)
+			for _, total := range []int{3, 7, 100} {
+				line := pr.RenderStatic(total, total)
+				if bar := barSegment(t, line); bar != strings.Repeat("#", width) {
+					t.Errorf("RenderStatic(%d, %d) bar = %q, want %d filled cells", total, total, bar, width)
+				}
+				if !strings.HasSuffix(stripAnsi(line), "100%") {
+					t.Errorf("RenderStatic(%d, %d) = %q, want it to end in 100%%", total, total, stripAnsi(line))
+				}
+				if bar := barSegment(t, pr.RenderStatic(0, total)); bar != strings.Repeat("-", width) {
+					t.Errorf("RenderStatic(0, %d) bar = %q, want %d empty cells", total, bar, width)
+				}
+			}
+		})
+	}
+}
+
+func TestProgressWideDoneChar(t *testing.T) {
+	pattern := ProgressPattern{DoneChar: "🟩", PendingChar: "-", PadLeft: "[", PadRight: "]"}
+	tests := []struct {
+		current, total int
+		want           string
+	}{
+		// 5 filled columns fit two 2-wide chars, padded with a space
+		{5, 9, "🟩🟩 ----"},
+		{4, 9, "🟩🟩-----"},
+		{1, 9, " --------"},
+		{9, 9, "🟩🟩🟩🟩 "},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d of %d", tt.current, tt.total), func(t *testing.T) {
+			pr := Progress().WithPrefix("").WithLabel("").WithPattern(pattern).WithWidth(9)
+			bar := barSegment(t, pr.RenderStatic(tt.current, tt.total))
+			if w := runewidth.StringWidth(bar); w != 9 {
+				t.Errorf("bar %q is %d columns wide, want 9", bar, w)
+			}
+			if bar != tt.want {
+				t.Errorf("bar = %q, want %q", bar, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgressWidthOnWideTerminal(t *testing.T) {
+	pattern := ProgressPattern{DoneChar: "#", PendingChar: "-", PadLeft: "[", PadRight: "]"}
+	pr := Progress().WithLabel("short").WithPattern(pattern).WithWidth(20)
+	for _, current := range []int{0, 7, 20} {
+		bar := barSegment(t, pr.barLine(current, 20, pr.prefix, 200))
+		if w := runewidth.StringWidth(bar); w != 20 {
+			t.Errorf("at %d of 20 the bar is %d cells on a 200-column terminal, want 20: %q", current, w, bar)
+		}
+	}
+}
@@ -1,8 +1,14 @@
 package asky
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
 	"strings"
 
+	"github.com/fatih/color"
 	"github.com/mattn/go-runewidth"
 )
 
@@ -10,33 +16,199 @@ import (
 type Choice struct {
 	Value string
 	Label string
+
+	// Color optionally overrides the label style for this choice while it
+	// is neither under the cursor nor selected, e.g. to tint a destructive
+	// option red.
+	Color *color.Color
+}
+
+// ChoicesFromStrings returns one [Choice] per string, using the string as
+// both the value and the label.
+//
+//	asky.Select().WithChoices(asky.ChoicesFromStrings([]string{"dev", "prod"}))
+func ChoicesFromStrings(ss []string) []Choice {
+	choices := make([]Choice, len(ss))
+	for i, v := range ss {
+		choices[i] = Choice{Value: v, Label: v}
+	}
+	return choices
+}
+
+// ChoicesFromMap returns one [Choice] per map entry, using the key as the
+// value and the map value as the label. Choices are sorted by key so the
+// order is stable across calls.
+//
+//	asky.Select().WithChoices(asky.ChoicesFromMap(map[string]string{
+//	    "dev":  "Development",
+//	    "prod": "Production",
+//	}))
+func ChoicesFromMap(m map[string]string) []Choice {
+	choices := make([]Choice, 0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		choices = append(choices, Choice{Value: k, Label: m[k]})
+	}
+	return choices
+}
+
+// runHook calls a WithBeforeRender or WithAfterRender hook, if set, with
+// the output writer.
+func runHook(hook func(io.Writer)) {
+	if hook != nil {
+		hook(stdOutput)
+	}
+}
+
+// echoAnswer prints the line set with WithEchoOnSubmit, filling the
+// placeholders of format from the alternating placeholder and value
+// pairs, such as "{label}", label.
+func echoAnswer(format string, styles *StyleMap, pairs ...string) {
+	line := strings.NewReplacer(pairs...).Replace(format)
+	stdOutput.Write([]byte(safeStyle(styles.LogSuccessPrefix).Sprint(line) + "\n"))
+}
+
+// debugState formats a prompt's resolved configuration for DebugState as
+// space-separated key=value pairs: the prompt kind, where its styles came
+// from, the accessibility and color switches, then the alternating keys
+// and values in pairs. String values are quoted.
+func debugState(kind string, cfg Config, pairs ...any) string {
+	styles := "custom"
+	switch cfg.Styles {
+	case nil:
+		styles = "none"
+	case defaultStyles:
+		styles = "default"
+	case pkgConfig.Styles:
+		styles = "configured"
+	}
+	parts := []string{
+		"prompt=" + kind,
+		"styles=" + styles,
+		fmt.Sprintf("accessible=%t", cfg.Accessible),
+		fmt.Sprintf("color=%t", !color.NoColor),
+	}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if s, ok := pairs[i+1].(string); ok {
+			parts = append(parts, fmt.Sprintf("%v=%q", pairs[i], s))
+		} else {
+			parts = append(parts, fmt.Sprintf("%v=%v", pairs[i], pairs[i+1]))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// runInterruptHandler calls a WithInterruptHandler callback, if set, when
+// *err is [ErrInterrupted]. Deferred from Render so it covers every Ctrl+C
+// path and runs before the after-render hook.
+func runInterruptHandler(fn func(), err *error) {
+	if fn != nil && errors.Is(*err, ErrInterrupted) {
+		fn()
+	}
+}
+
+// sortChoices returns a sorted copy of choices, or choices itself when less
+// is nil. The sort is stable, so choices that compare equal keep their order.
+func sortChoices(choices []Choice, less func(a, b Choice) bool) []Choice {
+	if less == nil {
+		return choices
+	}
+	sorted := slices.Clone(choices)
+	slices.SortStableFunc(sorted, func(a, b Choice) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	})
+	return sorted
+}
+
+// byLabel orders choices by label, ignoring case.
+func byLabel(a, b Choice) bool {
+	return strings.ToLower(a.Label) < strings.ToLower(b.Label)
+}
+
+// TextPrompter is implemented by prompts that return free-form text:
+// [Text], [Secret] and [MultilineText]. Accept it instead of a concrete
+// builder to swap in a fake during tests.
+type TextPrompter interface {
+	Render() (string, error)
+}
+
+// ConfirmPrompter is implemented by the [Confirm] prompt.
+type ConfirmPrompter interface {
+	Render() (bool, error)
 }
 
+// ChoicePrompter is implemented by the [Select] prompt.
+type ChoicePrompter interface {
+	Render() (Choice, error)
+}
+
+// MultiChoicePrompter is implemented by the [MultiSelect] prompt.
+type MultiChoicePrompter interface {
+	Render() ([]Choice, error)
+}
+
+var (
+	_ TextPrompter        = (*text)(nil)
+	_ TextPrompter        = (*secret)(nil)
+	_ TextPrompter        = (*multilineText)(nil)
+	_ ConfirmPrompter     = (*confirm)(nil)
+	_ ChoicePrompter      = (*singleSelect)(nil)
+	_ MultiChoicePrompter = (*multiSelect)(nil)
+)
+
 type selectionNav struct {
 	cursorIdx int
 	startIdx  int
 	endIdx    int
-	pageSize  int
+	pageSize  int // visible items, a whole number of rows
+	columns   int // items per row; zero means one
+	scrollOff int // rows kept between the cursor and the page edges
+}
+
+func (n *selectionNav) cols() int {
+	return max(1, n.columns)
 }
 
 func (n *selectionNav) up(total int) {
-	if n.cursorIdx > 0 {
-		n.cursorIdx--
-		if n.cursorIdx < n.startIdx {
-			n.startIdx = n.cursorIdx
-			n.endIdx = min(n.startIdx+n.pageSize, total)
-		}
-	}
+	n.moveTo(n.cursorIdx-n.cols(), total)
 }
 
 func (n *selectionNav) down(total int) {
-	if n.cursorIdx < total-1 {
-		n.cursorIdx++
-		if n.cursorIdx >= n.endIdx {
-			n.endIdx = n.cursorIdx + 1
-			n.startIdx = max(0, n.endIdx-n.pageSize)
-		}
+	c := n.cols()
+	if n.cursorIdx/c < (total-1)/c {
+		n.moveTo(min(n.cursorIdx+c, total-1), total)
+	}
+}
+
+func (n *selectionNav) left(total int) {
+	n.moveTo(n.cursorIdx-1, total)
+}
+
+func (n *selectionNav) right(total int) {
+	n.moveTo(n.cursorIdx+1, total)
+}
+
+// moveTo places the cursor on idx, scrolling the page by whole rows so the
+// cursor stays at least scrollOff rows from either edge. Out-of-range
+// indices are ignored.
+func (n *selectionNav) moveTo(idx, total int) {
+	if idx < 0 || idx >= total {
+		return
+	}
+	c := n.cols()
+	n.cursorIdx = idx
+	row, startRow, pageRows, off := idx/c, n.startIdx/c, n.pageSize/c, n.off()
+	if row < startRow+off {
+		startRow = row - off
+	} else if row > startRow+pageRows-1-off {
+		startRow = row - pageRows + 1 + off
 	}
+	n.scrollTo(startRow, total)
 }
 
 func (n *selectionNav) reset(total, pageSize int) {
@@ -48,43 +220,203 @@ func (n *selectionNav) reset(total, pageSize int) {
 	if n.cursorIdx >= total {
 		n.cursorIdx = total - 1
 	}
-	n.startIdx = max(0, n.cursorIdx-n.pageSize+1)
+	c := n.cols()
+	n.scrollTo(n.cursorIdx/c-n.pageSize/c+1+n.off(), total)
+}
+
+// off returns scrollOff, capped so the cursor can still reach every row of
+// the page.
+func (n *selectionNav) off() int {
+	return min(n.scrollOff, (n.pageSize/n.cols()-1)/2)
+}
+
+// scrollTo starts the page at row startRow, clamped to the list.
+func (n *selectionNav) scrollTo(startRow, total int) {
+	c := n.cols()
+	lastStart := max(0, (total+c-1)/c-n.pageSize/c)
+	n.startIdx = min(max(startRow, 0), lastStart) * c
 	n.endIdx = min(n.startIdx+n.pageSize, total)
 }
 
-func renderSelectionChoice(c Choice, cur, sel bool, printableWidth int, cursorIndicator, selectionMarker string, styles *StyleMap) string {
-	cursorWidth := runewidth.StringWidth(cursorIndicator)
-	selWidth := runewidth.StringWidth(selectionMarker)
+func renderSelectionChoice(c Choice, query string, cur, sel, disabled bool, hint string, printableWidth int, cursorIndicator, selectionMarker string, styles *StyleMap) string {
+	// Zero-width markers would collapse their column, so fall back to a space
+	if displayWidth(cursorIndicator) == 0 {
+		cursorIndicator = " "
+	}
+	if displayWidth(selectionMarker) == 0 {
+		selectionMarker = " "
+	}
+	// Every state draws the indicator column then the marker column, so
+	// spacers use the same widths as the symbols they stand in for
+	cursorWidth := displayWidth(cursorIndicator)
+	selWidth := displayWidth(selectionMarker)
 	cursorSpacer := strings.Repeat(" ", cursorWidth)
 	selSpacer := strings.Repeat(" ", selWidth)
-	label := TruncToWidth(c.Label, printableWidth-(cursorWidth+selWidth+1))
+	label := TruncToWidth(c.Label, printableWidth-(cursorWidth+selWidth+1+runewidth.StringWidth(hint)))
+	if hint != "" {
+		hint = safeStyle(styles.SelectionItemDefaultHint).Sprint(hint)
+	}
+	normalLabelStyle := styles.SelectionItemNormalLabel
+	if c.Color != nil {
+		normalLabelStyle = c.Color
+	}
 	switch {
+	case disabled:
+		// The disabled style wins over the cursor's, which stays visible
+		indicator := cursorSpacer
+		if cur {
+			indicator = cursorIndicator
+		}
+		return safeStyle(styles.SelectionItemDisabledLabel).Sprint(indicator) + selSpacer + " " +
+			highlightMatch(label, query, styles.SelectionItemDisabledLabel, styles.SelectionSearchMatch) + hint
 	case sel && cur:
 		return safeStyle(styles.SelectionItemSelectedMarker).Sprint(cursorIndicator+selectionMarker) + " " +
-			safeStyle(styles.SelectionItemSelectedLabel).Sprint(label)
+			highlightMatch(label, query, styles.SelectionItemSelectedLabel, styles.SelectionSearchMatch) + hint
 	case sel:
 		return cursorSpacer +
 			safeStyle(styles.SelectionItemSelectedMarker).Sprint(selectionMarker) + " " +
-			safeStyle(styles.SelectionItemSelectedLabel).Sprint(label)
+			highlightMatch(label, query, styles.SelectionItemSelectedLabel, styles.SelectionSearchMatch) + hint
 	case cur:
 		return safeStyle(styles.SelectionItemCurrentMarker).Sprint(cursorIndicator) + selSpacer + " " +
-			safeStyle(styles.SelectionItemCurrentLabel).Sprint(label)
+			highlightMatch(label, query, styles.SelectionItemCurrentLabel, styles.SelectionSearchMatch) + hint
 	default:
 		return cursorSpacer + selSpacer + " " +
-			safeStyle(styles.SelectionItemNormalLabel).Sprint(label)
+			highlightMatch(label, query, normalLabelStyle, styles.SelectionSearchMatch) + hint
+	}
+}
+
+// highlightMatch styles label with base, except for the first
+// case-insensitive occurrence of query, which is styled with match.
+func highlightMatch(label, query string, base, match *color.Color) string {
+	lower := strings.ToLower(label)
+	i := strings.Index(lower, strings.ToLower(query))
+	// Byte offsets only carry over when lowercasing kept the length
+	if query == "" || i < 0 || len(lower) != len(label) {
+		return safeStyle(base).Sprint(label)
 	}
+	j := i + len(query)
+	return safeStyle(base).Sprint(label[:i]) +
+		safeStyle(match).Sprint(label[i:j]) +
+		safeStyle(base).Sprint(label[j:])
 }
 
-func filterSelectionChoices(choices []Choice, query string) []Choice {
+// retainedSearch is the search a select prompt kept from its last Render,
+// for WithRetainSearch.
+type retainedSearch struct {
+	query string
+	mode  bool
+}
+
+// filterSelectionChoices returns the choices whose label, or value when
+// matchValue is set, contains query case-insensitively.
+func filterSelectionChoices(choices []Choice, query string, matchValue bool) []Choice {
 	if query == "" {
 		return choices
 	}
 	var filtered []Choice
 	q := strings.ToLower(query)
 	for _, c := range choices {
-		if strings.Contains(strings.ToLower(c.Label), q) {
+		if strings.Contains(strings.ToLower(c.Label), q) ||
+			matchValue && strings.Contains(strings.ToLower(c.Value), q) {
 			filtered = append(filtered, c)
 		}
 	}
 	return filtered
 }
+
+// choiceHint returns the dimmed text drawn after a choice label: the
+// choice's value in parentheses when showValue is set, then a default
+// marker when def is set.
+func choiceHint(c Choice, showValue, def bool) string {
+	hint := ""
+	if showValue {
+		hint += " (" + c.Value + ")"
+	}
+	if def {
+		hint += " (default)"
+	}
+	return hint
+}
+
+// alignSelectionRows pads each row on the left so it ends at column
+// width, for right-to-left choice labels. Rows are measured with
+// displayWidth, so wide and combining runes do not shift the alignment.
+func alignSelectionRows(rows []string, width int) []string {
+	for i, row := range rows {
+		rows[i] = strings.Repeat(" ", max(width-displayWidth(stripAnsi(row)), 0)) + row
+	}
+	return rows
+}
+
+// appendScrollbar pads each row to width and appends a space and one
+// scrollbar glyph, adding 2 columns. The "█" thumb spans the share of the
+// list that is visible and sits at its position: the rows shown start at
+// row first of total. The track is "│". Rows are returned as they are
+// when the whole list fits.
+func appendScrollbar(rows []string, width, first, total int, style *color.Color) []string {
+	n := len(rows)
+	if total <= n {
+		return rows
+	}
+	size := max(1, n*n/total)
+	pos := min(first*n/total, n-size)
+	if first+n >= total {
+		pos = n - size
+	}
+	for i, row := range rows {
+		glyph := "│"
+		if i >= pos && i < pos+size {
+			glyph = "█"
+		}
+		pad := max(width-displayWidth(stripAnsi(row)), 0)
+		rows[i] = row + strings.Repeat(" ", pad) + " " + safeStyle(style).Sprint(glyph)
+	}
+	return rows
+}
+
+// boxSelectionRows pads each row to innerWidth columns and frames the rows
+// with a single-line border, adding 4 columns and 2 rows.
+func boxSelectionRows(rows []string, innerWidth int, style *color.Color) []string {
+	border := safeStyle(style)
+	boxed := make([]string, 0, len(rows)+2)
+	boxed = append(boxed, border.Sprint("┌"+strings.Repeat("─", innerWidth+2)+"┐"))
+	for _, row := range rows {
+		pad := max(innerWidth-displayWidth(stripAnsi(row)), 0)
+		boxed = append(boxed, border.Sprint("│ ")+row+strings.Repeat(" ", pad)+border.Sprint(" │"))
+	}
+	return append(boxed, border.Sprint("└"+strings.Repeat("─", innerWidth+2)+"┘"))
+}
+
+// promptPrefix returns the styled prompt prefix followed by a space, or an
+// empty string when hidden so no styling or spacing is left behind.
+func promptPrefix(prefix string, hidden bool, style *color.Color) string {
+	if hidden {
+		return ""
+	}
+	return safeStyle(style).Sprint(pick(prefix, "(?)")) + " "
+}
+
+// compactSelectionHelp condenses the two-line selection help footer into
+// one line, for WithCompactHelp.
+func compactSelectionHelp(moveHint, spaceAction, submit string, searchMode, noSearch bool) string {
+	help := moveHint + " • space " + spaceAction + " • " + submit + " confirm"
+	switch {
+	case searchMode:
+		help += " • esc/tab nav"
+	case !noSearch:
+		help += " • tab search"
+	}
+	return help
+}
+
+// msgNoChoices is the validation message shown when Space is pressed with
+// no choices matching the search query.
+const msgNoChoices = "no choices available"
+
+// renderSelectionEmpty renders msg in the choice area, aligned with the
+// choice labels, for when no choices match the search query.
+func renderSelectionEmpty(msg string, printableWidth int, cursorIndicator, selectionMarker string, styles *StyleMap) string {
+	indent := max(displayWidth(cursorIndicator), 1) + max(displayWidth(selectionMarker), 1) + 1
+	return strings.Repeat(" ", indent) +
+		safeStyle(styles.SelectionSearchHint).Sprint(TruncToWidth(msg, printableWidth-indent))
+}
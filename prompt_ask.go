@@ -0,0 +1,54 @@
+package asky
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Ask shows a [Text] prompt with label and converts the answer with parse.
+// Input that parse rejects is reported live as the validation message and
+// blocks submission, so the returned value is always parsed successfully
+// unless the prompt itself fails (e.g. [ErrInterrupted]).
+//
+//	port, err := asky.Ask("Port", func(s string) (uint16, error) {
+//	    n, err := strconv.ParseUint(s, 10, 16)
+//	    return uint16(n), err
+//	})
+func Ask[T any](label string, parse func(string) (T, error)) (T, error) {
+	var zero T
+	v, err := Text().WithLabel(label).WithValidator(func(s string) (string, bool) {
+		if _, err := parse(s); err != nil {
+			return err.Error(), false
+		}
+		return "", true
+	}).Render()
+	if err != nil {
+		return zero, err
+	}
+	return parse(v)
+}
+
+// AskInt prompts for a whole number. Surrounding whitespace is ignored.
+func AskInt(label string) (int, error) {
+	return Ask(label, func(s string) (int, error) {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return 0, errors.New("must be a whole number")
+		}
+		return n, nil
+	})
+}
+
+// AskDuration prompts for a duration in [time.ParseDuration] format,
+// such as "90s" or "1h30m". Surrounding whitespace is ignored.
+func AskDuration(label string) (time.Duration, error) {
+	return Ask(label, func(s string) (time.Duration, error) {
+		d, err := time.ParseDuration(strings.TrimSpace(s))
+		if err != nil {
+			return 0, errors.New("must be a duration like 90s or 1h30m")
+		}
+		return d, nil
+	})
+}
@@ -2,6 +2,7 @@ package asky
 
 import (
 	"bufio"
+	"io"
 	"os"
 	"os/signal"
 	"strings"
@@ -11,10 +12,15 @@ import (
 // confirm renders an interactive yes/no prompt.
 // Construct one with [Confirm].
 type confirm struct {
-	cfg        Config
-	prefix     string
-	label      string
-	defaultVal *bool // nil = no default, user must explicitly select
+	cfg          Config
+	prefix       string
+	label        string
+	defaultVal   *bool // nil = no default, user must explicitly select
+	keyHandler   func(Key) (handled, stop bool)
+	hidePrefix   bool
+	beforeRender func(io.Writer)
+	afterRender  func(io.Writer)
+	onInterrupt  func()
 }
 
 // Confirm returns a builder for an interactive yes/no prompt.
@@ -41,23 +47,71 @@ func (c *confirm) WithPrefix(p string) *confirm {
 	return c
 }
 
+// WithPrefixHidden removes the prompt prefix and the space after it.
+func (c *confirm) WithPrefixHidden() *confirm {
+	c.hidePrefix = true
+	return c
+}
+
+// WithIconFallback sets the prefix to emoji, or to ascii on terminals
+// and locales where emoji are unlikely to render correctly.
+func (c *confirm) WithIconFallback(emoji, ascii string) *confirm {
+	c.prefix = iconFor(emoji, ascii)
+	return c
+}
+
 // WithLabel sets the prompt label shown to the user.
 func (c *confirm) WithLabel(l string) *confirm {
 	c.label = l
 	return c
 }
 
-// WithDefault pre-selects an option. If not called, the user must explicitly
-// select before confirming.
+// WithDefault pre-selects an option, which Enter accepts. The prompt shows
+// the Unix-style "[Y/n]" or "[y/N]" hint, with the default capitalized and
+// styled with ConfirmationSelectedItem. If not called, the hint reads
+// "[y/n]" and the user must press Y or N.
 func (c *confirm) WithDefault(v bool) *confirm {
 	c.defaultVal = &v
 	return c
 }
 
+// WithKeyHandler installs a hook consulted before the prompt's own key
+// handling. Returning handled skips the default action for that key;
+// returning stop exits the prompt with [ErrStopped]. Ignored in accessible mode.
+func (c *confirm) WithKeyHandler(fn func(k Key) (handled, stop bool)) *confirm {
+	c.keyHandler = fn
+	return c
+}
+
+// WithBeforeRender sets fn to run once before the prompt is drawn, with
+// the output writer, e.g. to print a header above the prompt.
+func (c *confirm) WithBeforeRender(fn func(w io.Writer)) *confirm {
+	c.beforeRender = fn
+	return c
+}
+
+// WithAfterRender sets fn to run once after the prompt is answered or
+// cancelled and its frame cleared, with the output writer.
+func (c *confirm) WithAfterRender(fn func(w io.Writer)) *confirm {
+	c.afterRender = fn
+	return c
+}
+
+// WithInterruptHandler sets fn to run when Ctrl+C cancels the prompt, just
+// before Render returns [ErrInterrupted], e.g. to save a draft.
+func (c *confirm) WithInterruptHandler(fn func()) *confirm {
+	c.onInterrupt = fn
+	return c
+}
+
 // Render displays the interactive prompt and blocks until the user confirms or
 // cancels. Returns true for yes, false for no, or [ErrInterrupted] if Ctrl+C
 // is pressed.
-func (c *confirm) Render() (bool, error) {
+func (c *confirm) Render() (_ bool, err error) {
+	runHook(c.beforeRender)
+	defer runHook(c.afterRender)
+	defer runInterruptHandler(c.onInterrupt, &err)
+
 	if c.cfg.Accessible {
 		return c.renderAccessible()
 	}
@@ -66,19 +120,12 @@ func (c *confirm) Render() (bool, error) {
 
 // renderAccessible collects a y/n answer without ANSI cursor movement.
 func (c *confirm) renderAccessible() (bool, error) {
-	prefix := pick(c.prefix, "(?)")
+	prefix := promptPrefix(c.prefix, c.hidePrefix, c.cfg.Styles.ConfirmationPrefix)
 
-	base := safeStyle(c.cfg.Styles.ConfirmationPrefix).Sprint(prefix) + " " +
-		safeStyle(c.cfg.Styles.ConfirmationLabel).Sprint(c.label) + " "
-
-	switch {
-	case c.defaultVal == nil:
-		base += safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("(type Y or N)")
-	case *c.defaultVal:
-		base += safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("(Y/n)")
-	default:
-		base += safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("(y/N)")
-	}
+	// Same hint as interactive mode, so both read "[y/N]"
+	base := prefix +
+		safeStyle(c.cfg.Styles.ConfirmationLabel).Sprint(c.label) + " " +
+		c.answerHint()
 
 	for {
 		stdOutput.Write([]byte(base + " "))
@@ -131,9 +178,13 @@ func (c *confirm) renderAccessible() (bool, error) {
 // Y/N keys confirm directly — no need to press Enter.
 // Cleans up after itself on exit.
 func (c *confirm) renderInteractive() (bool, error) {
-	prefix := pick(c.prefix, "(?)")
-	promptLine := safeStyle(c.cfg.Styles.ConfirmationPrefix).Sprint(prefix) + " " +
-		safeStyle(c.cfg.Styles.ConfirmationLabel).Sprint(c.label) + " "
+	// Guard against an unknown terminal size, before anything is written
+	if w, _, err := termSize(); err != nil || w <= 0 {
+		return false, ErrTerminalTooSmall
+	}
+
+	frameLines := c.frameLines()
+	promptLine := frameLines[0]
 
 	var selected *bool
 	if c.defaultVal != nil {
@@ -143,24 +194,14 @@ func (c *confirm) renderInteractive() (bool, error) {
 
 	var (
 		interrupted = false
+		stopped     = false
 		firstRender = true
 		cursorRow   = 0
 	)
 
-	var helpLine string
-	switch {
-	case c.defaultVal == nil:
-		helpLine = safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("press Y or N (selection mandatory) • ctrl+c to cancel")
-	case *c.defaultVal:
-		helpLine = safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("press Y or N (default: yes) • ctrl+c to cancel")
-	default:
-		helpLine = safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("press Y or N (default: no) • ctrl+c to cancel")
-	}
-
 	redraw := func() {
 		termW, _, _ := termSize()
 
-		frameLines := []string{promptLine, helpLine}
 		frameHeight := totalPhysicalLines(frameLines, termW)
 
 		// Move cursor back to row 0 of the frame
@@ -195,29 +236,37 @@ func (c *confirm) renderInteractive() (bool, error) {
 
 	// Hide cursor, defer cleanup
 	stdOutput.Write([]byte("\r" + ansiHideCursor))
-	defer func() {
-		ansiCursorUp(cursorRow)
-		stdOutput.Write([]byte("\r" + ansiClearScreen + ansiReset + ansiShowCursor))
-	}()
+	defer func() { restoreTerminal(cursorRow) }()
 
 	// Initial render
 	redraw()
 
 	// Intercept keyboard events & handle them
-	err := listenKeys(func(ev keyEvent) (stop bool) {
-		switch ev.code {
-		case keyCtrlC:
+	err := listenKeys(func(ev Key) (stop bool) {
+		if c.keyHandler != nil {
+			handled, exit := c.keyHandler(ev)
+			if exit {
+				stopped = true
+				return true
+			}
+			if handled {
+				return false
+			}
+		}
+
+		switch ev.Code {
+		case KeyCtrlC:
 			interrupted = true
 			return true
 
-		case keyEnter:
+		case KeyEnter:
 			if selected == nil {
 				return false // block until user presses Y or N
 			}
 			return true
 
-		case keyRune:
-			switch ev.r {
+		case KeyRune:
+			switch ev.Rune {
 			case 'y', 'Y':
 				v := true
 				selected = &v
@@ -239,9 +288,70 @@ func (c *confirm) renderInteractive() (bool, error) {
 	if interrupted {
 		return false, ErrInterrupted
 	}
+	if stopped {
+		return false, ErrStopped
+	}
 
 	if selected == nil {
 		return false, nil
 	}
 	return *selected, nil
 }
+
+// Preview returns the prompt's initial frame as it would first be drawn,
+// without reading input or moving the cursor. Useful for documentation
+// and layout debugging.
+func (c *confirm) Preview() string {
+	return strings.Join(c.frameLines(), "\n")
+}
+
+// DebugState returns the prompt's resolved configuration on one line, for
+// logging when it renders unexpectedly: whether its styles are the
+// built-in defaults, those set with [Configure], or its own from
+// WithStyles, the accessibility and color switches, and its main options.
+func (c *confirm) DebugState() string {
+	def := "none"
+	switch {
+	case c.defaultVal == nil:
+	case *c.defaultVal:
+		def = "yes"
+	default:
+		def = "no"
+	}
+	return debugState("confirm", c.cfg,
+		"label", c.label,
+		"default", def)
+}
+
+// frameLines builds the lines of the prompt frame: the prompt and the help
+// line describing the default answer.
+func (c *confirm) frameLines() []string {
+	promptLine := promptPrefix(c.prefix, c.hidePrefix, c.cfg.Styles.ConfirmationPrefix) +
+		safeStyle(c.cfg.Styles.ConfirmationLabel).Sprint(c.label) + " " + c.answerHint() + " "
+
+	var helpLine string
+	switch {
+	case c.defaultVal == nil:
+		helpLine = safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("press Y or N (selection mandatory) • ctrl+c to cancel")
+	case *c.defaultVal:
+		helpLine = safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("press Y or N (default: yes) • ctrl+c to cancel")
+	default:
+		helpLine = safeStyle(c.cfg.Styles.ConfirmationHelp).Sprint("press Y or N (default: no) • ctrl+c to cancel")
+	}
+	return []string{promptLine, helpLine}
+}
+
+// answerHint returns the "[y/N]" hint, with the default answer capitalized
+// and highlighted. Without a default both answers are lowercase.
+func (c *confirm) answerHint() string {
+	help := safeStyle(c.cfg.Styles.ConfirmationHelp)
+	yes, no := help.Sprint("y"), help.Sprint("n")
+	if c.defaultVal != nil {
+		if *c.defaultVal {
+			yes = safeStyle(c.cfg.Styles.ConfirmationSelectedItem).Sprint("Y")
+		} else {
+			no = safeStyle(c.cfg.Styles.ConfirmationSelectedItem).Sprint("N")
+		}
+	}
+	return help.Sprint("[") + yes + help.Sprint("/") + no + help.Sprint("]")
+}
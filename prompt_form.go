@@ -0,0 +1,455 @@
+package asky
+
+import (
+	"slices"
+	"strings"
+)
+
+// form renders several text, secret and select fields together and lets
+// the user move focus between them before submitting.
+// Construct one with [Form].
+type form struct {
+	cfg    Config
+	prefix string
+	label  string
+	fields []*formField
+	bell   bool
+	onBlur bool
+}
+
+// formField is one named field of a [form]. Exactly one of input or
+// choice is set, depending on how the field was added.
+type formField struct {
+	name   string
+	input  *text         // text and secret fields
+	choice *singleSelect // select fields
+
+	buf       []rune // text input state
+	cursorPos int
+	choiceIdx int // select state
+}
+
+// Form returns a builder for a multi-field form. Fields are configured with
+// the regular prompt builders; their labels, placeholders, defaults, echo
+// modes, choices and validators are reused.
+//
+//	values, err := asky.Form().
+//	    WithLabel("Create account").
+//	    WithText("user", asky.Text().WithLabel("Username")).
+//	    WithSecret("pass", asky.Secret().WithLabel("Password")).
+//	    WithSelect("plan", asky.Select().WithLabel("Plan").WithChoices(plans)).
+//	    Render()
+func Form() *form {
+	return &form{cfg: pkgConfig}
+}
+
+// WithStyles overrides the [StyleMap] for this form.
+func (f *form) WithStyles(s *StyleMap) *form {
+	f.cfg.Styles = s
+	return f
+}
+
+// WithPrefix overrides the default prompt prefix symbol.
+func (f *form) WithPrefix(p string) *form {
+	f.prefix = p
+	return f
+}
+
+// WithLabel sets the title shown above the fields.
+func (f *form) WithLabel(l string) *form {
+	f.label = l
+	return f
+}
+
+// WithText adds a text field whose value is returned under name.
+func (f *form) WithText(name string, t *text) *form {
+	f.fields = append(f.fields, &formField{name: name, input: t})
+	return f
+}
+
+// WithSecret adds a secret field whose value is returned under name.
+func (f *form) WithSecret(name string, s *secret) *form {
+	f.fields = append(f.fields, &formField{name: name, input: &s.text})
+	return f
+}
+
+// WithSelect adds a select field whose chosen [Choice.Value] is returned
+// under name. Left and right cycle through the choices.
+func (f *form) WithSelect(name string, s *singleSelect) *form {
+	f.fields = append(f.fields, &formField{name: name, choice: s})
+	return f
+}
+
+// WithBell rings the terminal bell when a submission fails validation.
+func (f *form) WithBell() *form {
+	f.bell = true
+	return f
+}
+
+// WithValidateOnBlur validates a field when focus leaves it, keeping focus
+// on the field and showing the failure inline until it passes. All fields
+// are still validated again on submit.
+func (f *form) WithValidateOnBlur() *form {
+	f.onBlur = true
+	return f
+}
+
+// Render displays the form and blocks until the user submits or cancels.
+// Returns the field values keyed by name, or [ErrInterrupted] if Ctrl+C
+// is pressed.
+//
+// Tab and Down move to the next field, Shift+Tab and Up to the previous.
+// Enter moves to the next field and submits from the last one; all
+// validators must pass before the form is accepted.
+//
+// In accessible mode, each field is asked in turn as a standalone prompt.
+func (f *form) Render() (map[string]string, error) {
+	if len(f.fields) == 0 {
+		return map[string]string{}, nil
+	}
+	for _, fld := range f.fields {
+		if fld.choice != nil && len(fld.choice.choices) == 0 {
+			return nil, ErrNoSelectionChoices
+		}
+	}
+	if f.cfg.Accessible {
+		return f.renderAccessible()
+	}
+	return f.renderInteractive()
+}
+
+// renderAccessible asks each field in order using its own accessible renderer.
+func (f *form) renderAccessible() (map[string]string, error) {
+	if f.label != "" {
+		stdOutput.Write([]byte(
+			safeStyle(f.cfg.Styles.InputPrefix).Sprint(pick(f.prefix, "(?)")) + " " +
+				safeStyle(f.cfg.Styles.InputLabel).Sprint(f.label) + "\n",
+		))
+	}
+
+	values := make(map[string]string, len(f.fields))
+	for _, fld := range f.fields {
+		if fld.input != nil {
+			v, err := fld.input.renderAccessible()
+			if err != nil {
+				return nil, err
+			}
+			values[fld.name] = v
+			continue
+		}
+		c, err := fld.choice.renderAccessible()
+		if err != nil {
+			return nil, err
+		}
+		values[fld.name] = c.Value
+	}
+	return values, nil
+}
+
+// renderInteractive renders all fields as one frame with live redraws.
+func (f *form) renderInteractive() (map[string]string, error) {
+	const minTermWidth = 42
+	minTermHeight := len(f.fields) + 6
+
+	var (
+		cursorRow   = 0 // zero-based row of cursor within the frame
+		focus       = 0
+		interrupted = false
+		firstRender = true
+		valMessage  = ""
+	)
+
+	// Guard against small terminal dimensions
+	if w, h, err := termSize(); err != nil || w < minTermWidth || h < minTermHeight {
+		return nil, ErrTerminalTooSmall
+	}
+
+	// Seed select fields with their preselected choice
+	for _, fld := range f.fields {
+		if fld.choice != nil && fld.choice.preSelected != nil {
+			fld.choiceIdx = max(0, slices.IndexFunc(fld.choice.choices, func(c Choice) bool {
+				return c.Value == *fld.choice.preSelected
+			}))
+		}
+	}
+
+	redraw := func() {
+		termW, termH, _ := termSize()
+
+		// Frame: [title, blank], fields..., blank, validation, help
+		var frameLines []string
+		if f.label != "" {
+			frameLines = append(frameLines,
+				safeStyle(f.cfg.Styles.InputPrefix).Sprint(pick(f.prefix, "(?)"))+" "+
+					safeStyle(f.cfg.Styles.InputLabel).Sprint(f.label),
+				"",
+			)
+		}
+		firstField := len(frameLines)
+		for i, fld := range f.fields {
+			frameLines = append(frameLines, f.fieldLine(fld, i == focus))
+		}
+		frameLines = append(frameLines,
+			"",
+			safeStyle(f.cfg.Styles.InputValidationFail).Sprint(valMessage),
+			safeStyle(f.cfg.Styles.InputHelp).Sprint("tab/shift+tab move  •  enter submit  •  ctrl+c cancel"),
+		)
+		frameHeight := totalPhysicalLines(frameLines, termW)
+
+		// Move cursor back to row 0 of the frame
+		if !firstRender {
+			ansiCursorUp(cursorRow)
+		}
+
+		if termH < frameHeight || termW < minTermWidth || termH < minTermHeight {
+			stdOutput.Write([]byte(
+				"\r" + ansiClearScreen +
+					safeStyle(f.cfg.Styles.InputValidationFail).Sprint("terminal too small to render content"),
+			))
+			cursorRow = 0
+			firstRender = true
+			return
+		}
+
+		// Write the full frame
+		stdOutput.Write([]byte(ansiHideCursor))
+
+		var b strings.Builder
+		for idx, line := range frameLines {
+			if idx == len(frameLines)-1 {
+				b.WriteString("\r" + line + ansiClearLine)
+			} else {
+				b.WriteString("\r" + line + ansiClearLine + "\n")
+			}
+		}
+		b.WriteString(ansiClearScreen)
+		stdOutput.Write([]byte(b.String()))
+
+		// Move from last frame line back to row 0
+		ansiCursorUp(frameHeight - 1)
+
+		// Reprint down to the focused field, then up to its cursor
+		before := frameLines[:firstField+focus]
+		var reprint strings.Builder
+		for _, line := range before {
+			reprint.WriteString("\r" + line + "\n")
+		}
+		fld := f.fields[focus]
+		var upToCursor string
+		switch {
+		case fld.choice != nil:
+			upToCursor = f.fieldLine(fld, true)
+		case fld.input.echo == EchoSilent:
+			upToCursor = f.fieldHead(fld, true)
+		default:
+			upToCursor = f.fieldHead(fld, true) +
+				safeStyle(fld.input.cfg.Styles.InputText).Sprint(fld.input.displayBuf(fld.buf[:fld.cursorPos]))
+		}
+		stdOutput.Write([]byte(reprint.String() + "\r" + upToCursor))
+		cursorRow = totalPhysicalLines(before, termW) + physicalLines(stripAnsi(upToCursor), termW) - 1
+
+		// Only text fields show a cursor
+		if fld.input != nil {
+			stdOutput.Write([]byte(ansiShowCursor))
+		}
+		firstRender = false
+	}
+
+	// validate checks every field and focuses the first invalid one.
+	validate := func() bool {
+		for i, fld := range f.fields {
+			if msg, ok := fld.validate(); !ok {
+				focus, valMessage = i, msg
+				return false
+			}
+		}
+		return true
+	}
+
+	// moveFocus moves focus by delta, unless blur validation rejects the
+	// current field. Reports whether focus moved.
+	moveFocus := func(delta int) bool {
+		if f.onBlur {
+			if msg, ok := f.fields[focus].validate(); !ok {
+				valMessage = msg
+				return false
+			}
+		}
+		focus = (focus + delta + len(f.fields)) % len(f.fields)
+		valMessage = ""
+		return true
+	}
+
+	// Prep for render, hide cursor, defer cleanup
+	stdOutput.Write([]byte("\r" + ansiHideCursor))
+	defer func() { restoreTerminal(cursorRow) }()
+
+	// Initial render
+	redraw()
+
+	// Intercept keyboard events & handle them
+	err := listenKeys(func(ev Key) (stop bool) {
+		fld := f.fields[focus]
+
+		switch ev.Code {
+		case KeyCtrlC:
+			interrupted = true
+			return true
+
+		case KeyTab, KeyDown:
+			if !moveFocus(1) && f.bell {
+				bell()
+			}
+			redraw()
+			return false
+
+		case KeyShiftTab, KeyUp:
+			if !moveFocus(-1) && f.bell {
+				bell()
+			}
+			redraw()
+			return false
+
+		case KeyEnter:
+			if focus < len(f.fields)-1 {
+				if !moveFocus(1) && f.bell {
+					bell()
+				}
+				redraw()
+				return false
+			}
+			if validate() {
+				return true
+			}
+			if f.bell {
+				bell()
+			}
+			redraw()
+			return false
+
+		default:
+			if fld.choice != nil {
+				f.handleChoiceKey(fld, ev)
+			} else {
+				f.handleTextKey(fld, ev)
+			}
+		}
+
+		valMessage = ""
+		redraw()
+		return false
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if interrupted {
+		return nil, ErrInterrupted
+	}
+
+	values := make(map[string]string, len(f.fields))
+	for _, fld := range f.fields {
+		if fld.choice != nil {
+			values[fld.name] = fld.choice.choices[fld.choiceIdx].Value
+			continue
+		}
+		v := string(fld.buf)
+		if v == "" {
+			v = fld.input.defaultValue
+		}
+		values[fld.name] = v
+	}
+	return values, nil
+}
+
+// validate runs the field's validator, if any, against its current value.
+func (fld *formField) validate() (string, bool) {
+	switch {
+	case fld.input != nil && fld.input.validator != nil:
+		msg, ok := fld.input.validator(string(fld.buf))
+		return fld.input.redact(msg, string(fld.buf)), ok
+	case fld.choice != nil && fld.choice.validator != nil:
+		return fld.choice.validator(fld.choice.choices[fld.choiceIdx])
+	}
+	return "", true
+}
+
+// fieldHead returns the marker and label that precede a field's value.
+func (f *form) fieldHead(fld *formField, focused bool) string {
+	marker := " "
+	if focused {
+		marker = safeStyle(f.cfg.Styles.InputPrefix).Sprint(">")
+	}
+	var label, required string
+	if fld.input != nil {
+		label, required = fld.input.label, fld.input.requiredMarker()
+	} else {
+		label, required = fld.choice.label, fld.choice.requiredMarker()
+	}
+	return marker + " " + safeStyle(f.cfg.Styles.InputLabel).Sprint(label) + required + ": "
+}
+
+// fieldLine returns the full rendered line for a field.
+func (f *form) fieldLine(fld *formField, focused bool) string {
+	head := f.fieldHead(fld, focused)
+	if fld.input != nil {
+		return head + fld.input.inputContent(fld.buf)
+	}
+	label := fld.choice.choices[fld.choiceIdx].Label
+	if !focused {
+		return head + safeStyle(f.cfg.Styles.SelectionItemNormalLabel).Sprint(label)
+	}
+	return head +
+		safeStyle(f.cfg.Styles.SelectionSearchHint).Sprint("‹ ") +
+		safeStyle(f.cfg.Styles.SelectionItemCurrentLabel).Sprint(label) +
+		safeStyle(f.cfg.Styles.SelectionSearchHint).Sprint(" ›")
+}
+
+// handleChoiceKey cycles a select field's choice.
+func (f *form) handleChoiceKey(fld *formField, ev Key) {
+	n := len(fld.choice.choices)
+	switch ev.Code {
+	case KeyLeft:
+		fld.choiceIdx = (fld.choiceIdx - 1 + n) % n
+	case KeyRight, KeySpace:
+		fld.choiceIdx = (fld.choiceIdx + 1) % n
+	}
+}
+
+// handleTextKey applies an editing key to a text or secret field.
+func (f *form) handleTextKey(fld *formField, ev Key) {
+	silent := fld.input.echo == EchoSilent
+	switch ev.Code {
+	case KeyLeft:
+		if !silent && fld.cursorPos > 0 {
+			fld.cursorPos--
+		}
+	case KeyRight:
+		if !silent && fld.cursorPos < len(fld.buf) {
+			fld.cursorPos++
+		}
+	case KeyHome, KeyCtrlHome:
+		if !silent {
+			fld.cursorPos = 0
+		}
+	case KeyEnd, KeyCtrlEnd:
+		if !silent {
+			fld.cursorPos = len(fld.buf)
+		}
+	case KeyBackspace:
+		if fld.cursorPos > 0 {
+			fld.buf = append(fld.buf[:fld.cursorPos-1], fld.buf[fld.cursorPos:]...)
+			fld.cursorPos--
+		}
+	case KeyDelete:
+		if !silent && fld.cursorPos < len(fld.buf) {
+			fld.buf = append(fld.buf[:fld.cursorPos], fld.buf[fld.cursorPos+1:]...)
+		}
+	case KeySpace:
+		fld.buf = slices.Insert(fld.buf, fld.cursorPos, ' ')
+		fld.cursorPos++
+	case KeyRune:
+		fld.buf = slices.Insert(fld.buf, fld.cursorPos, ev.Rune)
+		fld.cursorPos++
+	}
+}
@@ -2,6 +2,7 @@ package asky
 
 import (
 	"bufio"
+	"io"
 	"os"
 	"os/signal"
 	"slices"
@@ -18,6 +19,15 @@ type multilineText struct {
 	placeholder  string
 	defaultValue string
 	validator    func(string) (string, bool)
+	keyHandler   func(Key) (handled, stop bool)
+	submitKey    KeyCode
+	echoFormat   string
+	bell         bool
+	cursorStyle  CursorStyle
+	hidePrefix   bool
+	beforeRender func(io.Writer)
+	afterRender  func(io.Writer)
+	onInterrupt  func()
 }
 
 // MultilineText returns a builder for an interactive multi-line text prompt.
@@ -26,8 +36,9 @@ type multilineText struct {
 //	if errors.Is(err, asky.ErrInterrupted) { ... }
 func MultilineText() *multilineText {
 	return &multilineText{
-		cfg:   pkgConfig,
-		label: "Enter value",
+		cfg:       pkgConfig,
+		label:     "Enter value",
+		submitKey: KeyCtrlD,
 	}
 }
 
@@ -43,6 +54,19 @@ func (a *multilineText) WithPrefix(p string) *multilineText {
 	return a
 }
 
+// WithPrefixHidden removes the prompt prefix and the space after it.
+func (a *multilineText) WithPrefixHidden() *multilineText {
+	a.hidePrefix = true
+	return a
+}
+
+// WithIconFallback sets the prefix to emoji, or to ascii on terminals
+// and locales where emoji are unlikely to render correctly.
+func (a *multilineText) WithIconFallback(emoji, ascii string) *multilineText {
+	a.prefix = iconFor(emoji, ascii)
+	return a
+}
+
 // WithLabel sets the prompt label shown to the user.
 func (a *multilineText) WithLabel(l string) *multilineText {
 	a.label = l
@@ -68,24 +92,98 @@ func (a *multilineText) WithValidator(fn func(string) (string, bool)) *multiline
 	return a
 }
 
+// WithKeyHandler installs a hook consulted before the prompt's own key
+// handling. Returning handled skips the default action for that key;
+// returning stop exits the prompt with [ErrStopped]. Ignored in accessible mode.
+func (a *multilineText) WithKeyHandler(fn func(k Key) (handled, stop bool)) *multilineText {
+	a.keyHandler = fn
+	return a
+}
+
+// WithSubmitKey rebinds the key that submits the text from Ctrl+D to
+// code, e.g. [KeyCtrlS]. Ctrl+D is then ignored, Enter keeps inserting a
+// new line unless it is chosen as the submit key, and the help line names
+// the new key. Ignored in accessible mode.
+func (a *multilineText) WithSubmitKey(code KeyCode) *multilineText {
+	a.submitKey = code
+	return a
+}
+
+// WithEchoOnSubmit replaces the prompt, once the text is submitted, with a
+// single line built from format, whose {label} and {value} placeholders are
+// filled with the prompt label and the text with its line breaks shown as
+// "↵". Ignored in accessible mode, where the answer is already part of the
+// transcript.
+//
+//	.WithEchoOnSubmit("✓ {label}: {value}")
+func (a *multilineText) WithEchoOnSubmit(format string) *multilineText {
+	a.echoFormat = format
+	return a
+}
+
+// WithBell rings the terminal bell when an action is rejected, such as
+// a failed validation on submit.
+func (a *multilineText) WithBell() *multilineText {
+	a.bell = true
+	return a
+}
+
+// WithCursorStyle sets the cursor shape shown while the prompt is active.
+// The terminal's default cursor is restored when the prompt exits.
+func (a *multilineText) WithCursorStyle(style CursorStyle) *multilineText {
+	a.cursorStyle = style
+	return a
+}
+
+// WithBeforeRender sets fn to run once before the prompt is drawn, with
+// the output writer, e.g. to print a header above the prompt.
+func (a *multilineText) WithBeforeRender(fn func(w io.Writer)) *multilineText {
+	a.beforeRender = fn
+	return a
+}
+
+// WithAfterRender sets fn to run once after the prompt is answered or
+// cancelled and its frame cleared, with the output writer.
+func (a *multilineText) WithAfterRender(fn func(w io.Writer)) *multilineText {
+	a.afterRender = fn
+	return a
+}
+
+// WithInterruptHandler sets fn to run when Ctrl+C cancels the prompt, just
+// before Render returns [ErrInterrupted], e.g. to save a draft.
+func (a *multilineText) WithInterruptHandler(fn func()) *multilineText {
+	a.onInterrupt = fn
+	return a
+}
+
 // Render displays the interactive prompt and blocks until the user submits or
 // cancels. Returns the entered string, or [ErrInterrupted] if Ctrl+C is pressed.
 //
-// In accessible mode, input is collected line-by-line until a blank line is entered.
+// Lines are joined with "\n" and returned exactly as typed, including any
+// trailing empty lines. In accessible mode, input is collected line-by-line
+// until a blank line is entered, which ends input and is not included.
 // Validation is checked on submit and the prompt reprints until satisfied.
-func (a *multilineText) Render() (string, error) {
+func (a *multilineText) Render() (_ string, err error) {
+	runHook(a.beforeRender)
+	defer runHook(a.afterRender)
+	defer runInterruptHandler(a.onInterrupt, &err)
+
 	if a.cfg.Accessible {
 		return a.renderAccessible()
 	}
-	return a.renderInteractive()
+	value, err := a.renderInteractive()
+	if err == nil && a.echoFormat != "" {
+		echoAnswer(a.echoFormat, a.cfg.Styles, "{label}", a.label, "{value}", strings.ReplaceAll(value, "\n", " ↵ "))
+	}
+	return value, err
 }
 
 // renderAccessible collects multiline input without cursor magic.
 // Lines are read until the user enters a blank line to submit.
 // Validation is checked on submit and the prompt reprints on failure.
 func (a *multilineText) renderAccessible() (string, error) {
-	prefix := pick(a.prefix, "(?)")
-	promptLine := safeStyle(a.cfg.Styles.InputPrefix).Sprint(prefix) + " " +
+	prefix := promptPrefix(a.prefix, a.hidePrefix, a.cfg.Styles.InputPrefix)
+	promptLine := prefix +
 		safeStyle(a.cfg.Styles.InputLabel).Sprint(a.label)
 
 	placeholder := ""
@@ -145,6 +243,9 @@ func (a *multilineText) renderAccessible() (string, error) {
 		if a.validator != nil {
 			msg, ok := a.validator(result)
 			if !ok {
+				if a.bell {
+					bell()
+				}
 				stdOutput.Write([]byte(safeStyle(a.cfg.Styles.InputValidationFail).Sprint(msg) + "\n\n"))
 				continue
 			}
@@ -175,12 +276,12 @@ func (a *multilineText) renderInteractive() (string, error) {
 	)
 
 	var (
-		cursorRow     = 0 // zero-based row of cursor within the full frame
-		prefix        = pick(a.prefix, "(?)")
+		cursorRow     = 0            // zero-based row of cursor within the full frame
 		lines         = [][]rune{{}} // at least one line
 		lineIdx       = 0            // which line the cursor is on
 		colIdx        = 0            // cursor column within the current line
 		interrupted   = false
+		stopped       = false
 		receivedInput = false
 		firstRender   = true
 	)
@@ -191,9 +292,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 	}
 
 	// Build static segments
-	promptLine := safeStyle(a.cfg.Styles.InputPrefix).Sprint(prefix) + " " +
-		safeStyle(a.cfg.Styles.InputLabel).Sprint(a.label) + ":"
-	helpLine := safeStyle(a.cfg.Styles.InputHelp).Sprint("ctrl+d to confirm  •  ctrl+c to cancel")
+	promptLine := a.promptLine()
 
 	// joinLines returns the full text content from all lines.
 	joinLines := func() string {
@@ -204,42 +303,15 @@ func (a *multilineText) renderInteractive() (string, error) {
 		return strings.Join(parts, "\n")
 	}
 
-	// buildContentLines returns the display lines for the text area.
-	buildContentLines := func() []string {
-		if len(lines) == 1 && len(lines[0]) == 0 {
-			// Empty — show placeholder or default
-			if a.defaultValue != "" && a.placeholder != "" {
-				return []string{safeStyle(a.cfg.Styles.InputPlaceholder).Sprint(a.placeholder + " (default: " + a.defaultValue + ")")}
-			} else if a.defaultValue != "" {
-				return []string{safeStyle(a.cfg.Styles.InputPlaceholder).Sprint(a.defaultValue)}
-			} else if a.placeholder != "" {
-				return []string{safeStyle(a.cfg.Styles.InputPlaceholder).Sprint(a.placeholder)}
-			}
-			return []string{""}
-		}
-		result := make([]string, len(lines))
-		for idx, l := range lines {
-			result[idx] = safeStyle(a.cfg.Styles.InputText).Sprint(string(l))
-		}
-		return result
-	}
-
 	redraw := func(validationMsg string) {
 		termW, termH, _ := termSize()
 
-		// Build the content lines
-		contentLines := buildContentLines()
-
 		// Only show validation after user has started typing
-		validationLine := ""
-		if a.validator != nil && receivedInput && validationMsg != "" {
-			validationLine = safeStyle(a.cfg.Styles.InputValidationFail).Sprint(validationMsg)
+		if a.validator == nil || !receivedInput {
+			validationMsg = ""
 		}
 
-		// Frame: prompt, blank, content..., blank, validation, help
-		frameLines := []string{promptLine, ""}
-		frameLines = append(frameLines, contentLines...)
-		frameLines = append(frameLines, "", validationLine, helpLine)
+		frameLines := a.frameLines(lines, validationMsg)
 		frameHeight := totalPhysicalLines(frameLines, termW)
 
 		// Move cursor back to row 0 of the frame
@@ -320,26 +392,43 @@ func (a *multilineText) renderInteractive() (string, error) {
 
 	// Prep for render, hide cursor, defer cleanup
 	stdOutput.Write([]byte("\r" + ansiHideCursor))
-	defer func() {
-		ansiCursorUp(cursorRow)
-		stdOutput.Write([]byte("\r" + ansiClearScreen + ansiReset + ansiShowCursor))
-	}()
+	defer func() { restoreTerminal(cursorRow) }()
+	ansiCursorStyle(a.cursorStyle)
 
 	// Initial render
 	redraw("")
 
-	err := listenKeys(func(ev keyEvent) (stop bool) {
-		switch ev.code {
-		case keyCtrlC:
+	err := listenKeys(func(ev Key) (stop bool) {
+		if a.keyHandler != nil {
+			handled, exit := a.keyHandler(ev)
+			if exit {
+				stopped = true
+				return true
+			}
+			if handled {
+				return false
+			}
+		}
+
+		ev, ok := remapSubmitKey(ev, a.submitKey, KeyCtrlD)
+		if !ok {
+			return false
+		}
+
+		switch ev.Code {
+		case KeyCtrlC:
 			interrupted = true
 			return true
 
-		case keyCtrlD:
+		case KeyCtrlD:
 			// Submit
 			if a.validator != nil {
 				msg, ok := a.validator(joinLines())
 				if !ok {
 					receivedInput = true
+					if a.bell {
+						bell()
+					}
 					redraw(msg)
 					return false
 				}
@@ -350,7 +439,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 			receivedInput = true
 			return true
 
-		case keyEnter:
+		case KeyEnter:
 			// Insert a new line
 			tail := append([]rune{}, lines[lineIdx][colIdx:]...)
 			lines[lineIdx] = lines[lineIdx][:colIdx]
@@ -358,7 +447,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 			lineIdx++
 			colIdx = 0
 
-		case keyLeft:
+		case KeyLeft:
 			if colIdx > 0 {
 				colIdx--
 			} else if lineIdx > 0 {
@@ -366,7 +455,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 				colIdx = len(lines[lineIdx])
 			}
 
-		case keyRight:
+		case KeyRight:
 			if colIdx < len(lines[lineIdx]) {
 				colIdx++
 			} else if lineIdx < len(lines)-1 {
@@ -374,7 +463,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 				colIdx = 0
 			}
 
-		case keyUp:
+		case KeyUp:
 			if lineIdx > 0 {
 				lineIdx--
 				if colIdx > len(lines[lineIdx]) {
@@ -382,7 +471,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 				}
 			}
 
-		case keyDown:
+		case KeyDown:
 			if lineIdx < len(lines)-1 {
 				lineIdx++
 				if colIdx > len(lines[lineIdx]) {
@@ -390,13 +479,13 @@ func (a *multilineText) renderInteractive() (string, error) {
 				}
 			}
 
-		case keyHome, keyCtrlHome:
+		case KeyHome, KeyCtrlHome:
 			colIdx = 0
 
-		case keyEnd, keyCtrlEnd:
+		case KeyEnd, KeyCtrlEnd:
 			colIdx = len(lines[lineIdx])
 
-		case keyCtrlLeft:
+		case KeyCtrlLeft:
 			if colIdx > 0 {
 				colIdx--
 				for colIdx > 0 && lines[lineIdx][colIdx-1] == ' ' {
@@ -410,7 +499,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 				colIdx = len(lines[lineIdx])
 			}
 
-		case keyCtrlRight:
+		case KeyCtrlRight:
 			if colIdx < len(lines[lineIdx]) {
 				for colIdx < len(lines[lineIdx]) && lines[lineIdx][colIdx] == ' ' {
 					colIdx++
@@ -423,7 +512,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 				colIdx = 0
 			}
 
-		case keyBackspace:
+		case KeyBackspace:
 			if colIdx > 0 {
 				lines[lineIdx] = append(lines[lineIdx][:colIdx-1], lines[lineIdx][colIdx:]...)
 				colIdx--
@@ -435,7 +524,7 @@ func (a *multilineText) renderInteractive() (string, error) {
 				lineIdx--
 			}
 
-		case keyDelete:
+		case KeyDelete:
 			if colIdx < len(lines[lineIdx]) {
 				lines[lineIdx] = append(lines[lineIdx][:colIdx], lines[lineIdx][colIdx+1:]...)
 			} else if lineIdx < len(lines)-1 {
@@ -444,12 +533,12 @@ func (a *multilineText) renderInteractive() (string, error) {
 				lines = append(lines[:lineIdx+1], lines[lineIdx+2:]...)
 			}
 
-		case keySpace:
+		case KeySpace:
 			lines[lineIdx] = slices.Insert(lines[lineIdx], colIdx, ' ')
 			colIdx++
 
-		case keyRune:
-			lines[lineIdx] = slices.Insert(lines[lineIdx], colIdx, ev.r)
+		case KeyRune:
+			lines[lineIdx] = slices.Insert(lines[lineIdx], colIdx, ev.Rune)
 			colIdx++
 		}
 
@@ -474,6 +563,67 @@ func (a *multilineText) renderInteractive() (string, error) {
 	if interrupted {
 		return "", ErrInterrupted
 	}
+	if stopped {
+		return "", ErrStopped
+	}
 
 	return joinLines(), nil
 }
+
+// Preview returns the prompt's initial frame as it would first be drawn,
+// without reading input or moving the cursor. Useful for documentation
+// and layout debugging.
+func (a *multilineText) Preview() string {
+	return strings.Join(a.frameLines([][]rune{{}}, ""), "\n")
+}
+
+// DebugState returns the prompt's resolved configuration on one line, for
+// logging when it renders unexpectedly: whether its styles are the
+// built-in defaults, those set with [Configure], or its own from
+// WithStyles, the accessibility and color switches, and its main options.
+func (a *multilineText) DebugState() string {
+	return debugState("multiline", a.cfg,
+		"label", a.label,
+		"validator", a.validator != nil)
+}
+
+// promptLine returns the styled prefix and label shown above the text area.
+func (a *multilineText) promptLine() string {
+	return promptPrefix(a.prefix, a.hidePrefix, a.cfg.Styles.InputPrefix) +
+		safeStyle(a.cfg.Styles.InputLabel).Sprint(a.label) + ":"
+}
+
+// contentLines returns the display lines for the text area, falling back
+// to the placeholder and default value while lines is empty.
+func (a *multilineText) contentLines(lines [][]rune) []string {
+	if len(lines) == 1 && len(lines[0]) == 0 {
+		// Empty — show placeholder or default
+		if a.defaultValue != "" && a.placeholder != "" {
+			return []string{safeStyle(a.cfg.Styles.InputPlaceholder).Sprint(a.placeholder + " (default: " + a.defaultValue + ")")}
+		} else if a.defaultValue != "" {
+			return []string{safeStyle(a.cfg.Styles.InputPlaceholder).Sprint(a.defaultValue)}
+		} else if a.placeholder != "" {
+			return []string{safeStyle(a.cfg.Styles.InputPlaceholder).Sprint(a.placeholder)}
+		}
+		return []string{""}
+	}
+	result := make([]string, len(lines))
+	for idx, l := range lines {
+		result[idx] = safeStyle(a.cfg.Styles.InputText).Sprint(string(l))
+	}
+	return result
+}
+
+// frameLines builds the lines of one frame: prompt, blank, content...,
+// blank, validation message (if any) and help line.
+func (a *multilineText) frameLines(lines [][]rune, validationMsg string) []string {
+	validationLine := ""
+	if validationMsg != "" {
+		validationLine = safeStyle(a.cfg.Styles.InputValidationFail).Sprint(validationMsg)
+	}
+	helpLine := safeStyle(a.cfg.Styles.InputHelp).Sprint(keyLabel(a.submitKey) + " to confirm  •  ctrl+c to cancel")
+
+	frameLines := []string{a.promptLine(), ""}
+	frameLines = append(frameLines, a.contentLines(lines)...)
+	return append(frameLines, "", validationLine, helpLine)
+}
@@ -2,11 +2,15 @@ package asky
 
 import (
 	"bufio"
+	"io"
 	"os"
 	"os/signal"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
+
+	"github.com/mattn/go-runewidth"
 )
 
 // multiSelect renders an interactive multi-selection prompt.
@@ -16,12 +20,46 @@ type multiSelect struct {
 	prefix          string
 	label           string
 	choices         []Choice
+	sortLess        func(a, b Choice) bool
 	preSelected     []string
+	selectAll       bool
 	cursorIndicator string
 	selectionMarker string
 	pageSize        int
+	scrollOff       int
 	selectedChoices []Choice
 	validator       func([]Choice) (string, bool)
+	dependencyRule  func(selected []Choice, candidate Choice) bool
+	keyHandler      func(Key) (handled, stop bool)
+	submitKey       KeyCode
+	echoFormat      string
+	onHighlight     func(Choice)
+	bell            bool
+	showPosition    bool
+	maxAttempts     int
+	emptyMessage    string
+	maxLabelWidth   int
+	showValues      bool
+	searchValues    bool
+	verticalOnly    bool
+	noSearch        bool
+	initialSearch   string
+	retainSearch    bool
+	retained        *retainedSearch
+	compactHelp     bool
+	rtl             bool
+	scrollbar       bool
+	mouse           bool
+	boxWidth        int
+	columns         int
+	showSummary     bool
+	confirmSubmit   bool
+	confirmMsg      string
+	hidePrefix      bool
+	showRequired    bool
+	beforeRender    func(io.Writer)
+	afterRender     func(io.Writer)
+	onInterrupt     func()
 }
 
 // MultiSelect returns a builder for an interactive multi-selection prompt.
@@ -36,6 +74,7 @@ func MultiSelect() *multiSelect {
 		cursorIndicator: ">",
 		selectionMarker: "*",
 		pageSize:        10,
+		submitKey:       KeyEnter,
 	}
 }
 
@@ -51,6 +90,27 @@ func (s *multiSelect) WithPrefix(p string) *multiSelect {
 	return s
 }
 
+// WithPrefixHidden removes the prompt prefix and the space after it.
+func (s *multiSelect) WithPrefixHidden() *multiSelect {
+	s.hidePrefix = true
+	return s
+}
+
+// WithRequiredMarker shows a "*" after the label to mark the prompt as
+// required. The marker is omitted while the prompt has preselected choices,
+// since submitting without input is then allowed.
+func (s *multiSelect) WithRequiredMarker() *multiSelect {
+	s.showRequired = true
+	return s
+}
+
+// WithIconFallback sets the prefix to emoji, or to ascii on terminals
+// and locales where emoji are unlikely to render correctly.
+func (s *multiSelect) WithIconFallback(emoji, ascii string) *multiSelect {
+	s.prefix = iconFor(emoji, ascii)
+	return s
+}
+
 // WithLabel sets the prompt label shown to the user.
 func (s *multiSelect) WithLabel(l string) *multiSelect {
 	s.label = l
@@ -59,29 +119,78 @@ func (s *multiSelect) WithLabel(l string) *multiSelect {
 
 // WithChoices sets the list of choices available for selection.
 func (s *multiSelect) WithChoices(ch []Choice) *multiSelect {
-	s.choices = ch
+	s.choices = sortChoices(ch, s.sortLess)
+	return s
+}
+
+// WithSortChoices displays the choices ordered by less, whether they are
+// set before or after this call. The caller's slice is left untouched, and
+// defaults still resolve by value.
+func (s *multiSelect) WithSortChoices(less func(a, b Choice) bool) *multiSelect {
+	s.sortLess = less
+	s.choices = sortChoices(s.choices, less)
 	return s
 }
 
-// WithDefaultChoices sets the list of choices to be selected by default.
+// WithSortByLabel displays the choices ordered by label, ignoring case.
+func (s *multiSelect) WithSortByLabel() *multiSelect {
+	return s.WithSortChoices(byLabel)
+}
+
+// WithSelectedChoices selects the choices whose [Choice.Value] is in
+// values when the prompt opens. Matching by value keeps the defaults
+// correct when choices are built dynamically or sorted; unknown values are
+// ignored. Each is marked with a dimmed "(default)" hint in the list.
 func (m *multiSelect) WithSelectedChoices(values []string) *multiSelect {
 	m.preSelected = values
+	m.selectAll = false
 	return m
 }
 
-// WithPageSize sets the number of choices visible at once.
+// WithAllSelected starts the prompt with every choice selected, without
+// the "(default)" hint, skipping any that [multiSelect.WithDependencyRule]
+// makes unavailable given the choices before them. It replaces any
+// [multiSelect.WithSelectedChoices].
+func (s *multiSelect) WithAllSelected() *multiSelect {
+	s.preSelected = nil
+	s.selectAll = true
+	return s
+}
+
+// WithNoneSelected starts the prompt with nothing selected, clearing any
+// earlier [multiSelect.WithSelectedChoices] or [multiSelect.WithAllSelected].
+func (s *multiSelect) WithNoneSelected() *multiSelect {
+	s.preSelected = nil
+	s.selectAll = false
+	return s
+}
+
+// WithPageSize sets the number of choices visible at once (minimum 1).
+// The page shrinks to fit when the terminal is too short to show n choices.
+// With [multiSelect.WithColumns], n counts rows rather than choices.
 func (s *multiSelect) WithPageSize(n int) *multiSelect {
-	s.pageSize = n
+	s.pageSize = max(1, n)
+	return s
+}
+
+// WithScrollOff keeps the cursor at least n rows away from the top and
+// bottom of the page while scrolling, like vim's scrolloff, so the list
+// moves around the cursor. n is capped at half the page; a large value
+// keeps the cursor centered. Defaults to 0, which scrolls only at the edge.
+func (s *multiSelect) WithScrollOff(n int) *multiSelect {
+	s.scrollOff = max(0, n)
 	return s
 }
 
 // WithCursorIndicator overrides the cursor indicator symbol.
+// An empty or zero-width indicator is rendered as a single space.
 func (s *multiSelect) WithCursorIndicator(ind string) *multiSelect {
 	s.cursorIndicator = ind
 	return s
 }
 
 // WithSelectionMarker overrides the selection marker symbol.
+// An empty or zero-width marker is rendered as a single space.
 func (s *multiSelect) WithSelectionMarker(mrk string) *multiSelect {
 	s.selectionMarker = mrk
 	return s
@@ -94,32 +203,288 @@ func (s *multiSelect) WithValidator(v func([]Choice) (string, bool)) *multiSelec
 	return s
 }
 
+// WithDependencyRule makes choices unavailable depending on what else is
+// selected. rule is called with the current selection and an unselected
+// candidate, and returns false while the candidate may not be picked, e.g.
+// because a conflicting choice is selected. Unavailable choices are drawn
+// with the SelectionItemDisabledLabel style and refuse Space; selected
+// choices can always be deselected. Defaults from WithSelectedChoices and
+// WithAllSelected are applied in list order under the same rule. In
+// accessible mode the entered set is checked choice by choice against the
+// rest.
+//
+//	asky.MultiSelect().WithDependencyRule(func(selected []asky.Choice, c asky.Choice) bool {
+//	    return c.Value != "sqlite" || !slices.ContainsFunc(selected, func(s asky.Choice) bool { return s.Value == "postgres" })
+//	})
+func (s *multiSelect) WithDependencyRule(rule func(selected []Choice, candidate Choice) bool) *multiSelect {
+	s.dependencyRule = rule
+	return s
+}
+
+// WithKeyHandler installs a hook consulted before the prompt's own key
+// handling. Returning handled skips the default action for that key;
+// returning stop exits the prompt with [ErrStopped]. Ignored in accessible mode.
+func (s *multiSelect) WithKeyHandler(fn func(k Key) (handled, stop bool)) *multiSelect {
+	s.keyHandler = fn
+	return s
+}
+
+// WithSubmitKey rebinds the key that confirms the selection from Enter to
+// code, e.g. [KeyCtrlS] for terminals where Enter is unreliable. Enter is
+// then ignored, and the help line names the new key. Ignored in
+// accessible mode.
+func (s *multiSelect) WithSubmitKey(code KeyCode) *multiSelect {
+	s.submitKey = code
+	return s
+}
+
+// WithEchoOnSubmit replaces the prompt, once the selection is confirmed,
+// with a single line built from format. Its {label} placeholder is filled
+// with the prompt label, {value} with the chosen [Choice.Value]s and
+// {choice} with the chosen [Choice.Label]s, each list separated by
+// commas. Ignored in accessible mode, where the answer is already part of
+// the transcript.
+//
+//	.WithEchoOnSubmit("✓ {label}: {choice}")
+func (s *multiSelect) WithEchoOnSubmit(format string) *multiSelect {
+	s.echoFormat = format
+	return s
+}
+
+// WithOnHighlight sets fn to run whenever the cursor lands on a different
+// choice, including the first one drawn, e.g. to render a preview of the
+// item elsewhere on screen. fn runs on the input goroutine after the frame
+// is drawn, so slow work should be handed off. Ignored in accessible mode.
+func (s *multiSelect) WithOnHighlight(fn func(c Choice)) *multiSelect {
+	s.onHighlight = fn
+	return s
+}
+
+// WithBell rings the terminal bell when an action is rejected, such as
+// a failed validation on submit or selecting from an empty list.
+func (s *multiSelect) WithBell() *multiSelect {
+	s.bell = true
+	return s
+}
+
+// WithPositionIndicator shows the cursor position within the filtered list
+// (e.g. "12/340") on the search line.
+func (s *multiSelect) WithPositionIndicator() *multiSelect {
+	s.showPosition = true
+	return s
+}
+
+// WithMaxAttempts gives up with [ErrTooManyAttempts] once the validator has
+// rejected n submissions. Zero (the default) allows unlimited attempts.
+func (s *multiSelect) WithMaxAttempts(n int) *multiSelect {
+	s.maxAttempts = n
+	return s
+}
+
+// WithEmptyMessage sets a message shown in place of the choice list when
+// the search query matches nothing, e.g. "No matching choices".
+func (s *multiSelect) WithEmptyMessage(msg string) *multiSelect {
+	s.emptyMessage = msg
+	return s
+}
+
+// WithMaxLabelWidth truncates choice labels wider than n columns with an
+// ellipsis. Zero (the default) only truncates at the terminal width.
+// Search still matches against the full label.
+func (s *multiSelect) WithMaxLabelWidth(n int) *multiSelect {
+	s.maxLabelWidth = max(0, n)
+	return s
+}
+
+// WithShowValues draws each choice's value dimmed in parentheses after its
+// label, e.g. "Production (prod)", for menus where labels are ambiguous
+// but values are canonical. Values are not searched unless
+// WithSearchIncludeValue is also set.
+func (s *multiSelect) WithShowValues() *multiSelect {
+	s.showValues = true
+	return s
+}
+
+// WithSearchIncludeValue makes the search match choice values as well as
+// labels.
+func (s *multiSelect) WithSearchIncludeValue() *multiSelect {
+	s.searchValues = true
+	return s
+}
+
+// WithVerticalOnly stops the h/l keys from moving the cursor, leaving only
+// the vertical keys (↑/↓ and j/k) for navigation. Left and Right arrows
+// only move the cursor in a multi-column layout (see
+// [multiSelect.WithColumns]), and not at all with this option.
+func (s *multiSelect) WithVerticalOnly() *multiSelect {
+	s.verticalOnly = true
+	return s
+}
+
+// WithSearchDisabled removes the search line and its hint and turns off
+// Tab-to-search, for short lists where searching adds only clutter.
+func (s *multiSelect) WithSearchDisabled() *multiSelect {
+	s.noSearch = true
+	return s
+}
+
+// WithInitialSearch opens the prompt in search mode with q already typed,
+// so the list starts filtered. Ignored with WithSearchDisabled and in
+// accessible mode.
+func (s *multiSelect) WithInitialSearch(q string) *multiSelect {
+	s.initialSearch = q
+	return s
+}
+
+// WithRetainSearch keeps the search query, and whether search mode was
+// on, from one Render to the next on the same prompt, so a select shown
+// in a loop reopens with the user's filter. It takes precedence over
+// WithInitialSearch after the first Render.
+func (s *multiSelect) WithRetainSearch() *multiSelect {
+	s.retainSearch = true
+	return s
+}
+
+// WithCompactHelp folds the help footer into a single line, leaving one
+// more row for choices on short terminals.
+func (s *multiSelect) WithCompactHelp() *multiSelect {
+	s.compactHelp = true
+	return s
+}
+
+// WithRTL right-aligns the choice rows to the list width, for Arabic,
+// Hebrew and other right-to-left labels. Labels are not reordered; the
+// terminal remains responsible for bidi display.
+func (s *multiSelect) WithRTL() *multiSelect {
+	s.rtl = true
+	return s
+}
+
+// WithScrollbar draws a scrollbar to the right of the choices, whose thumb
+// shows the size and position of the visible page within the filtered
+// list. It takes 2 columns from the list and is blank while every choice
+// fits on the page.
+func (s *multiSelect) WithScrollbar() *multiSelect {
+	s.scrollbar = true
+	return s
+}
+
+// WithMouse turns on mouse reporting while the prompt is active, so the
+// scroll wheel moves the cursor like the arrow keys. Most terminals only
+// allow text selection with Shift held while reporting is on. Ignored in
+// accessible mode.
+func (s *multiSelect) WithMouse() *multiSelect {
+	s.mouse = true
+	return s
+}
+
+// WithWidth constrains the choice list to n columns, including a border
+// drawn around it. Rows are padded or truncated to fit, and n is capped at
+// the terminal width. Zero (the default) draws no border.
+func (s *multiSelect) WithWidth(n int) *multiSelect {
+	s.boxWidth = max(0, n)
+	return s
+}
+
+// WithColumns lays the choices out in n columns, filled row by row.
+// The page size then counts rows, so up to n times as many choices are
+// visible at once; ←/→ (and h/l) move between columns and ↑/↓ between rows.
+// Values below 1 are treated as 1, the default single-column list.
+func (s *multiSelect) WithColumns(n int) *multiSelect {
+	s.columns = max(1, n)
+	return s
+}
+
+// WithSelectionSummary adds a line under the search line listing the
+// labels of the selected choices, truncated to the terminal width.
+func (s *multiSelect) WithSelectionSummary() *multiSelect {
+	s.showSummary = true
+	return s
+}
+
+// WithConfirmBeforeSubmit requires a second consecutive Enter (or the key
+// set with WithSubmitKey) to submit. The first press, once validation
+// passes, shows msg, or a default "press enter again to confirm N
+// selections" naming the submit key; any other key cancels the
+// confirmation. Applies to interactive mode only.
+func (s *multiSelect) WithConfirmBeforeSubmit(msg string) *multiSelect {
+	s.confirmSubmit = true
+	s.confirmMsg = msg
+	return s
+}
+
+// WithBeforeRender sets fn to run once before the prompt is drawn, with
+// the output writer, e.g. to print a header above the prompt.
+func (s *multiSelect) WithBeforeRender(fn func(w io.Writer)) *multiSelect {
+	s.beforeRender = fn
+	return s
+}
+
+// WithAfterRender sets fn to run once after the prompt is answered or
+// cancelled and its frame cleared, with the output writer.
+func (s *multiSelect) WithAfterRender(fn func(w io.Writer)) *multiSelect {
+	s.afterRender = fn
+	return s
+}
+
+// WithInterruptHandler sets fn to run when Ctrl+C cancels the prompt, just
+// before Render returns [ErrInterrupted], e.g. to save a draft.
+func (s *multiSelect) WithInterruptHandler(fn func()) *multiSelect {
+	s.onInterrupt = fn
+	return s
+}
+
 // Render displays the prompt and blocks until the user confirms or cancels.
 // Returns the selected choices, or [ErrInterrupted] if Ctrl+C is pressed.
 //
 // In accessible mode, choices are printed as a numbered list and the user
 // types comma-separated indices. In interactive mode, choices are navigated
 // with arrow keys and toggled with space.
-func (s *multiSelect) Render() ([]Choice, error) {
+func (s *multiSelect) Render() (_ []Choice, err error) {
 	if len(s.choices) == 0 {
 		return nil, ErrNoSelectionChoices
 	}
 
-	// Pre-populate selected choices from WithSelectedChoices
+	runHook(s.beforeRender)
+	defer runHook(s.afterRender)
+	defer runInterruptHandler(s.onInterrupt, &err)
+
+	// Pre-populate selected choices from WithSelectedChoices, replacing
+	// any left over from an earlier Render
+	s.selectedChoices = nil
+	s.applyPreSelected()
+
+	if s.cfg.Accessible {
+		return s.renderAccessible()
+	}
+	choices, err := s.renderInteractive()
+	if err == nil && s.echoFormat != "" {
+		values, labels := make([]string, len(choices)), make([]string, len(choices))
+		for i, c := range choices {
+			values[i], labels[i] = c.Value, c.Label
+		}
+		echoAnswer(s.echoFormat, s.cfg.Styles,
+			"{label}", s.label,
+			"{value}", strings.Join(values, ", "),
+			"{choice}", strings.Join(labels, ", "))
+	}
+	return choices, err
+}
+
+// applyPreSelected adds the choices set with [multiSelect.WithSelectedChoices]
+// or [multiSelect.WithAllSelected] to the selection.
+func (s *multiSelect) applyPreSelected() {
 	preSelectedSet := make(map[string]bool)
 	for _, v := range s.preSelected {
 		preSelectedSet[v] = true
 	}
+	// Choices are added one at a time, in order, so the dependency rule
+	// sees the same growing selection as it would for Space
 	for _, c := range s.choices {
-		if preSelectedSet[c.Value] {
+		if (s.selectAll || preSelectedSet[c.Value]) && !s.isDisabled(c) {
 			s.selectedChoices = append(s.selectedChoices, c)
 		}
 	}
-
-	if s.cfg.Accessible {
-		return s.renderAccessible()
-	}
-	return s.renderInteractive()
 }
 
 // isSelected reports whether c is in the current selection.
@@ -132,6 +497,12 @@ func (s *multiSelect) isSelected(c Choice) bool {
 	return false
 }
 
+// isDisabled reports whether the dependency rule currently forbids
+// selecting c. Selected choices are never disabled, so they can be removed.
+func (s *multiSelect) isDisabled(c Choice) bool {
+	return s.dependencyRule != nil && !s.isSelected(c) && !s.dependencyRule(s.selectedChoices, c)
+}
+
 // toggleChoice adds c to the selection if not present, or removes it if present.
 func (s *multiSelect) toggleChoice(c Choice) {
 	for i, sel := range s.selectedChoices {
@@ -148,17 +519,24 @@ func (s *multiSelect) toggleChoice(c Choice) {
 func (s *multiSelect) renderAccessible() ([]Choice, error) {
 
 	// Print the header
-	prefix := pick(s.prefix, "(?)")
+	prefix := promptPrefix(s.prefix, s.hidePrefix, s.cfg.Styles.SelectionPrefix)
 	stdOutput.Write([]byte(
-		safeStyle(s.cfg.Styles.SelectionPrefix).Sprint(prefix+" ") +
-			safeStyle(s.cfg.Styles.SelectionLabel).Sprint(s.label) + "\n",
+		prefix +
+			safeStyle(s.cfg.Styles.SelectionLabel).Sprint(s.label) + s.requiredMarker() + "\n",
 	))
 
 	// Print numbered choices
 	width := len(strconv.Itoa(len(s.choices)))
 	for i, c := range s.choices {
 		num := safeStyle(s.cfg.Styles.SelectionSearchHint).Sprintf("%*d. ", width, i+1)
-		label := safeStyle(s.cfg.Styles.SelectionItemNormalLabel).Sprint(c.Label)
+		labelStyle := s.cfg.Styles.SelectionItemNormalLabel
+		if c.Color != nil {
+			labelStyle = c.Color
+		}
+		label := safeStyle(labelStyle).Sprint(c.Label)
+		if s.showValues {
+			label += safeStyle(s.cfg.Styles.SelectionItemDefaultHint).Sprint(" (" + c.Value + ")")
+		}
 		marker := ""
 		for _, sel := range s.selectedChoices {
 			if sel.Value == c.Value {
@@ -169,9 +547,10 @@ func (s *multiSelect) renderAccessible() ([]Choice, error) {
 		stdOutput.Write([]byte("  " + num + label + marker + "\n"))
 	}
 
-	promptStr := safeStyle(s.cfg.Styles.SelectionPrefix).Sprint(prefix) + " " +
+	promptStr := prefix +
 		safeStyle(s.cfg.Styles.SelectionLabel).Sprintf("Enter numbers separated by commas: ")
 
+	attempts := 0
 	for {
 		stdOutput.Write([]byte(promptStr))
 
@@ -210,12 +589,22 @@ func (s *multiSelect) renderAccessible() ([]Choice, error) {
 			if len(s.selectedChoices) > 0 {
 				if s.validator != nil {
 					if msg, ok := s.validator(s.selectedChoices); !ok {
+						if s.bell {
+							bell()
+						}
 						stdOutput.Write([]byte(safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(msg) + "\n"))
+						attempts++
+						if s.maxAttempts > 0 && attempts >= s.maxAttempts {
+							return nil, ErrTooManyAttempts
+						}
 						continue
 					}
 				}
 				return s.selectedChoices, nil
 			}
+			if s.bell {
+				bell()
+			}
 			stdOutput.Write([]byte(safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint("please enter at least one number") + "\n"))
 			continue
 		}
@@ -227,6 +616,9 @@ func (s *multiSelect) renderAccessible() ([]Choice, error) {
 			part = strings.TrimSpace(part)
 			n, err := strconv.Atoi(part)
 			if err != nil || n < 1 || n > len(s.choices) {
+				if s.bell {
+					bell()
+				}
 				stdOutput.Write([]byte(
 					safeStyle(s.cfg.Styles.SelectionValidationFail).
 						Sprintf("invalid choice %q — enter numbers between 1 and %d\n", part, len(s.choices)),
@@ -240,9 +632,34 @@ func (s *multiSelect) renderAccessible() ([]Choice, error) {
 			continue
 		}
 
+		// Each chosen item must be allowed alongside the others
+		if s.dependencyRule != nil {
+			for i, c := range chosen {
+				others := append(slices.Clone(chosen[:i]), chosen[i+1:]...)
+				if !s.dependencyRule(others, c) {
+					if s.bell {
+						bell()
+					}
+					stdOutput.Write([]byte(safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(c.Label+" is unavailable with this selection") + "\n"))
+					valid = false
+					break
+				}
+			}
+			if !valid {
+				continue
+			}
+		}
+
 		if s.validator != nil {
 			if msg, ok := s.validator(chosen); !ok {
+				if s.bell {
+					bell()
+				}
 				stdOutput.Write([]byte(safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(msg) + "\n"))
+				attempts++
+				if s.maxAttempts > 0 && attempts >= s.maxAttempts {
+					return nil, ErrTooManyAttempts
+				}
 				continue
 			}
 		}
@@ -260,14 +677,22 @@ func (s *multiSelect) renderInteractive() ([]Choice, error) {
 	)
 	var (
 		interrupted     = false
+		stopped         = false
+		exhausted       = false
+		confirming      = false
+		attempts        = 0
 		searchQuery     = ""
 		searchMode      = false
 		filteredChoices = s.choices
-		nav             = &selectionNav{}
+		nav             = &selectionNav{scrollOff: s.scrollOff}
 		valMessage      = ""
 		prevHeight      = 0
+		highlighted     *Choice
 	)
 
+	// Open with the initial search applied, if any
+	searchQuery, searchMode, filteredChoices = s.initialSearchState()
+
 	// Initialize navigation
 	nav.reset(len(filteredChoices), min(s.pageSize, len(filteredChoices)))
 
@@ -276,67 +701,10 @@ func (s *multiSelect) renderInteractive() ([]Choice, error) {
 		return nil, ErrTerminalTooSmall
 	}
 
-	// Build the header lines
-	promptLine := safeStyle(s.cfg.Styles.SelectionPrefix).Sprint(pick(s.prefix, "(?)")) + " " +
-		safeStyle(s.cfg.Styles.SelectionLabel).Sprint(s.label)
-	searchLabel := safeStyle(s.cfg.Styles.SelectionSearchLabel).Sprint("Search: ")
-	headerLines := []string{promptLine, ""}
-
 	// Multi-Select Prompt Renderer
 	redraw := func() {
 		newW, newH, _ := termSize()
-
-		// Build the current search line
-		searchLine := searchLabel + safeStyle(s.cfg.Styles.SelectionSearchText).Sprint(searchQuery)
-		if searchMode {
-			searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" • " + strconv.Itoa(len(filteredChoices)) + " hits")
-		}
-		searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" (" + strconv.Itoa(len(s.selectedChoices)) + " selected)")
-
-		// Update the header lines & compute the frame height for header
-		headerLines[1] = searchLine
-		headerLinesHeight := totalPhysicalLines(headerLines, newW)
-
-		// Build the footer lines & compute the frame height for footer
-		footerLines := []string{""}
-		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(valMessage))
-		if searchMode {
-			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("↑/↓ move • space toggle • enter confirm"))
-			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("type to search (esc/tab nav)"))
-		} else {
-			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("↑/↓ move • space toggle • enter confirm"))
-			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("tab to search"))
-		}
-		footerLinesHeight := totalPhysicalLines(footerLines, newW)
-
-		// Compute page size & reset navigation if needed
-		pageSize := min(s.pageSize, len(filteredChoices), newH-headerLinesHeight-footerLinesHeight)
-		if pageSize != nav.pageSize && pageSize > 0 {
-			nav.reset(len(filteredChoices), pageSize)
-		}
-
-		// Build contentLines
-		var contentLines []string
-		contentLines = append(contentLines, headerLines...)
-
-		// Build content for the visible choices list & pad the rest with empty lines
-		for i := nav.startIdx; i < nav.endIdx; i++ {
-			contentLines = append(contentLines, renderSelectionChoice(
-				filteredChoices[i],
-				i == nav.cursorIdx,
-				s.isSelected(filteredChoices[i]),
-				newW-1,
-				s.cursorIndicator,
-				s.selectionMarker,
-				s.cfg.Styles),
-			)
-		}
-
-		// Pad the rest to maintain consistent height
-		for i := nav.endIdx - nav.startIdx; i < nav.pageSize; i++ {
-			contentLines = append(contentLines, "")
-		}
-		contentLines = append(contentLines, footerLines...)
+		contentLines := s.frameLines(filteredChoices, nav, searchQuery, searchMode, valMessage, newW, newH)
 
 		// Compute new frame's physical height at current width
 		newHeight := totalPhysicalLines(contentLines, newW)
@@ -347,6 +715,9 @@ func (s *multiSelect) renderInteractive() ([]Choice, error) {
 				"\r" + ansiClearScreen +
 					safeStyle(s.cfg.Styles.SelectionItemCurrentMarker).Sprint("terminal too small to render content"),
 			))
+			// The cursor is now on the frame's first row; cleanup and the
+			// next frame must not move above it
+			prevHeight = 0
 			return
 		}
 
@@ -368,71 +739,155 @@ func (s *multiSelect) renderInteractive() ([]Choice, error) {
 
 		stdOutput.Write([]byte(b.String()))
 		prevHeight = newHeight - 1
+
+		// Report the highlighted choice only when it changes
+		if s.onHighlight != nil && len(filteredChoices) > 0 {
+			if cur := filteredChoices[nav.cursorIdx]; highlighted == nil || cur.Value != highlighted.Value {
+				highlighted = &cur
+				s.onHighlight(cur)
+			}
+		}
 	}
 
 	// Prep for render, hide cursor, defer cleanup
 	stdOutput.Write([]byte("\r" + ansiHideCursor))
-	defer func() {
-		ansiCursorUp(prevHeight)
-		stdOutput.Write([]byte("\r" + ansiClearScreen + ansiReset + ansiShowCursor))
-	}()
+	defer func() { restoreTerminal(prevHeight) }()
+	if s.mouse {
+		stdOutput.Write([]byte(ansiMouseOn))
+		defer func() { stdOutput.Write([]byte(ansiMouseOff)) }()
+	}
 
 	// Initial render
 	redraw()
 
 	// Handle user input & redraw per keystroke
-	err := listenKeys(func(ev keyEvent) (stop bool) {
-		switch ev.code {
-		case keyCtrlC:
+	err := listenKeys(func(ev Key) (stop bool) {
+		if s.keyHandler != nil {
+			handled, exit := s.keyHandler(ev)
+			if exit {
+				stopped = true
+				return true
+			}
+			if handled {
+				return false
+			}
+		}
+
+		ev, ok := remapSubmitKey(ev, s.submitKey, KeyEnter)
+		if !ok {
+			return false
+		}
+
+		// Any key other than Enter cancels a pending confirmation
+		if confirming && ev.Code != KeyEnter {
+			confirming = false
+			valMessage = ""
+		}
+
+		switch ev.Code {
+		case KeyCtrlC:
 			interrupted = true
 			return true
-		case keyUp:
+		case KeyUp, KeyWheelUp:
 			nav.up(len(filteredChoices))
-		case keyDown:
+		case KeyDown, KeyWheelDown:
 			nav.down(len(filteredChoices))
-		case keyTab:
-			searchMode = !searchMode
-		case keyEscape:
+		case KeyLeft:
+			if s.columns > 1 && !s.verticalOnly {
+				nav.left(len(filteredChoices))
+			}
+		case KeyRight:
+			if s.columns > 1 && !s.verticalOnly {
+				nav.right(len(filteredChoices))
+			}
+		case KeyTab:
+			searchMode = !searchMode && !s.noSearch
+		case KeyEscape:
 			searchMode = false
-		case keyEnter:
+		case KeyEnter:
 			if s.validator != nil {
 				if msg, ok := s.validator(s.selectedChoices); !ok {
+					if s.bell {
+						bell()
+					}
 					valMessage = msg
+					attempts++
+					if s.maxAttempts > 0 && attempts >= s.maxAttempts {
+						exhausted = true
+						return true
+					}
 					break
 				}
 			}
+			if s.confirmSubmit && !confirming {
+				confirming = true
+				valMessage = pick(s.confirmMsg, "press "+keyLabel(s.submitKey)+" again to confirm "+strconv.Itoa(len(s.selectedChoices))+" selections")
+				break
+			}
 			return true
-		case keySpace:
+		case KeySpace:
 			if len(filteredChoices) == 0 {
-				valMessage = "no choices available"
+				if s.bell {
+					bell()
+				}
+				valMessage = msgNoChoices
+				break
+			}
+			if c := filteredChoices[nav.cursorIdx]; s.isDisabled(c) {
+				if s.bell {
+					bell()
+				}
+				valMessage = c.Label + " is unavailable with the current selection"
 				break
 			}
 			s.toggleChoice(filteredChoices[nav.cursorIdx])
 			valMessage = ""
-		case keyBackspace:
+		case KeyBackspace:
 			if searchMode && len(searchQuery) > 0 {
 				searchQuery = searchQuery[:len(searchQuery)-1]
-				filteredChoices = filterSelectionChoices(s.choices, searchQuery)
+				filteredChoices = filterSelectionChoices(s.choices, searchQuery, s.searchValues)
 				nav.reset(len(filteredChoices), nav.pageSize)
 			}
-		case keyRune:
+		case KeyRune:
 			if searchMode {
-				searchQuery += string(ev.r)
-				filteredChoices = filterSelectionChoices(s.choices, searchQuery)
+				searchQuery += string(ev.Rune)
+				filteredChoices = filterSelectionChoices(s.choices, searchQuery, s.searchValues)
 				nav.reset(len(filteredChoices), nav.pageSize)
 			} else {
-				switch ev.r {
-				case 'j', 'l':
+				switch {
+				case ev.Rune == 'j':
 					nav.down(len(filteredChoices))
-				case 'k', 'h':
+				case ev.Rune == 'k':
 					nav.up(len(filteredChoices))
+				case ev.Rune == 'l' && !s.verticalOnly:
+					if s.columns > 1 {
+						nav.right(len(filteredChoices))
+					} else {
+						nav.down(len(filteredChoices))
+					}
+				case ev.Rune == 'h' && !s.verticalOnly:
+					if s.columns > 1 {
+						nav.left(len(filteredChoices))
+					} else {
+						nav.up(len(filteredChoices))
+					}
 				}
 			}
 		}
+
+		// Once the search matches again, the empty-list warning is stale
+		if valMessage == msgNoChoices && len(filteredChoices) > 0 {
+			valMessage = ""
+		}
 		redraw()
 		return false
 	})
 
+	// Keep the search for the next Render
+	if s.retainSearch {
+		s.retained = &retainedSearch{query: searchQuery, mode: searchMode}
+	}
+
 	// Handle errors, edge cases, interrupts and return selected choices
 	if err != nil {
 		return nil, err
@@ -440,5 +895,215 @@ func (s *multiSelect) renderInteractive() ([]Choice, error) {
 	if interrupted {
 		return nil, ErrInterrupted
 	}
+	if stopped {
+		return nil, ErrStopped
+	}
+	if exhausted {
+		return nil, ErrTooManyAttempts
+	}
 	return s.selectedChoices, nil
 }
+
+// Preview returns the prompt's initial frame as it would first be drawn,
+// without reading input or moving the cursor. Useful for documentation
+// and layout debugging.
+func (s *multiSelect) Preview() string {
+	selected := s.selectedChoices
+	defer func() { s.selectedChoices = selected }()
+	s.selectedChoices = nil
+	s.applyPreSelected()
+
+	termW, termH := previewSize()
+	nav := &selectionNav{scrollOff: s.scrollOff}
+	query, searchMode, filtered := s.initialSearchState()
+	nav.reset(len(filtered), min(s.pageSize, len(filtered)))
+	return strings.Join(s.frameLines(filtered, nav, query, searchMode, "", termW, termH), "\n")
+}
+
+// initialSearchState returns the search query, search mode and filtered
+// choices the prompt opens with.
+func (s *multiSelect) initialSearchState() (string, bool, []Choice) {
+	if s.noSearch {
+		return "", false, s.choices
+	}
+	if s.retained != nil {
+		return s.retained.query, s.retained.mode, filterSelectionChoices(s.choices, s.retained.query, s.searchValues)
+	}
+	if s.initialSearch == "" {
+		return "", false, s.choices
+	}
+	return s.initialSearch, true, filterSelectionChoices(s.choices, s.initialSearch, s.searchValues)
+}
+
+// DebugState returns the prompt's resolved configuration on one line, for
+// logging when it renders unexpectedly: whether its styles are the
+// built-in defaults, those set with [Configure], or its own from
+// WithStyles, the accessibility and color switches, and its main options.
+func (s *multiSelect) DebugState() string {
+	return debugState("multiselect", s.cfg,
+		"label", s.label,
+		"choices", len(s.choices),
+		"pageSize", s.pageSize,
+		"columns", max(1, s.columns),
+		"search", !s.noSearch,
+		"validator", s.validator != nil)
+}
+
+// summaryLine lists the selected labels after a "Selected: " label,
+// truncated to width columns.
+func (s *multiSelect) summaryLine(width int) string {
+	const label = "Selected: "
+	if len(s.selectedChoices) == 0 {
+		return safeStyle(s.cfg.Styles.SelectionSearchLabel).Sprint(label) +
+			safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint("none")
+	}
+	labels := make([]string, len(s.selectedChoices))
+	for i, c := range s.selectedChoices {
+		labels[i] = c.Label
+	}
+	return safeStyle(s.cfg.Styles.SelectionSearchLabel).Sprint(label) +
+		safeStyle(s.cfg.Styles.SelectionItemSelectedLabel).Sprint(TruncToWidth(strings.Join(labels, ", "), width-runewidth.StringWidth(label)))
+}
+
+// requiredMarker returns the styled required marker, or "" when hidden.
+func (s *multiSelect) requiredMarker() string {
+	if !s.showRequired || len(s.preSelected) > 0 || s.selectAll {
+		return ""
+	}
+	return safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint("*")
+}
+
+// frameLines builds the lines of one frame for a terminal of termW x termH:
+// the header, the visible page of filtered choices padded to the page size,
+// and the footer. nav is resized in place when the page size changes.
+func (s *multiSelect) frameLines(filteredChoices []Choice, nav *selectionNav, searchQuery string, searchMode bool, valMessage string, termW, termH int) []string {
+	// Build the header lines
+	promptLine := promptPrefix(s.prefix, s.hidePrefix, s.cfg.Styles.SelectionPrefix) +
+		safeStyle(s.cfg.Styles.SelectionLabel).Sprint(s.label) + s.requiredMarker()
+	searchLabel := safeStyle(s.cfg.Styles.SelectionSearchLabel).Sprint("Search: ")
+
+	// Build the current search line
+	searchLine := searchLabel + safeStyle(s.cfg.Styles.SelectionSearchText).Sprint(searchQuery)
+	if searchMode {
+		searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" • " + strconv.Itoa(len(filteredChoices)) + " hits")
+	}
+	searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" (" + strconv.Itoa(len(s.selectedChoices)) + " selected)")
+
+	if s.showPosition {
+		pos := 0
+		if len(filteredChoices) > 0 {
+			pos = nav.cursorIdx + 1
+		}
+		searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" • " + strconv.Itoa(pos) + "/" + strconv.Itoa(len(filteredChoices)))
+	}
+
+	// Compute the frame height for header
+	headerLines := []string{promptLine, searchLine}
+	if s.noSearch {
+		headerLines = headerLines[:1]
+	}
+	if s.showSummary {
+		headerLines = append(headerLines, s.summaryLine(termW-1))
+	}
+	headerLinesHeight := totalPhysicalLines(headerLines, termW)
+
+	// Build the footer lines & compute the frame height for footer
+	moveHint := "↑/↓ move"
+	if s.columns > 1 && !s.verticalOnly {
+		moveHint = "↑/↓/←/→ move"
+	}
+	footerLines := []string{""}
+	footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(valMessage))
+	switch {
+	case s.compactHelp:
+		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint(compactSelectionHelp(moveHint, "toggle", keyLabel(s.submitKey), searchMode, s.noSearch)))
+	case searchMode:
+		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint(moveHint+" • space toggle • "+keyLabel(s.submitKey)+" confirm"))
+		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("type to search (esc/tab nav)"))
+	default:
+		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint(moveHint+" • space toggle • "+keyLabel(s.submitKey)+" confirm"))
+		if !s.noSearch {
+			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("tab to search"))
+		}
+	}
+	footerLinesHeight := totalPhysicalLines(footerLines, termW)
+
+	// Compute page size in rows & reset navigation if needed
+	listW, boxRows := termW-1, 0
+	if s.boxWidth > 0 {
+		listW, boxRows = max(min(s.boxWidth, termW-1)-4, 1), 2
+	}
+	if s.scrollbar {
+		listW = max(listW-2, 1)
+	}
+	cols := max(1, s.columns)
+	totalRows := (len(filteredChoices) + cols - 1) / cols
+	listRows := termH - headerLinesHeight - footerLinesHeight - boxRows
+	pageSize := min(s.pageSize, totalRows, listRows) * cols
+	if (pageSize != nav.pageSize || cols != nav.cols()) && pageSize > 0 {
+		nav.columns = cols
+		nav.reset(len(filteredChoices), pageSize)
+	}
+
+	// Build rows for the visible choices list, cols cells per row
+	cellW := listW / cols
+	var listLines []string
+	for row := nav.startIdx; row < nav.endIdx; row += cols {
+		var line strings.Builder
+		for i := row; i < min(row+cols, nav.endIdx); i++ {
+			choice := filteredChoices[i]
+			if s.maxLabelWidth > 0 {
+				choice.Label = TruncToWidth(choice.Label, s.maxLabelWidth)
+			}
+			cell := renderSelectionChoice(
+				choice,
+				searchQuery,
+				i == nav.cursorIdx,
+				s.isSelected(filteredChoices[i]),
+				s.isDisabled(filteredChoices[i]),
+				choiceHint(choice, s.showValues, slices.Contains(s.preSelected, filteredChoices[i].Value)),
+				cellW,
+				s.cursorIndicator,
+				s.selectionMarker,
+				s.cfg.Styles,
+			)
+			line.WriteString(cell)
+			if i < row+cols-1 && i < nav.endIdx-1 {
+				line.WriteString(strings.Repeat(" ", max(cellW-displayWidth(stripAnsi(cell)), 0)))
+			}
+		}
+		listLines = append(listLines, line.String())
+	}
+
+	// Show the empty message in the first row when nothing matches
+	padFrom := len(listLines)
+	if len(filteredChoices) == 0 && s.emptyMessage != "" && listRows > 0 {
+		listLines = append(listLines, renderSelectionEmpty(
+			s.emptyMessage,
+			listW,
+			s.cursorIndicator,
+			s.selectionMarker,
+			s.cfg.Styles),
+		)
+		padFrom++
+	}
+
+	if s.rtl {
+		listLines = alignSelectionRows(listLines, listW)
+	}
+
+	// Pad the rest to maintain consistent height
+	for i := padFrom; i < nav.pageSize/nav.cols(); i++ {
+		listLines = append(listLines, "")
+	}
+	if s.scrollbar {
+		listLines = appendScrollbar(listLines, listW, nav.startIdx/cols, totalRows, s.cfg.Styles.SelectionHelp)
+		listW += 2
+	}
+	if s.boxWidth > 0 {
+		listLines = boxSelectionRows(listLines, listW, s.cfg.Styles.SelectionHelp)
+	}
+
+	contentLines := append(headerLines, listLines...)
+	return append(contentLines, footerLines...)
+}
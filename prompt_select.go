@@ -3,13 +3,19 @@ package asky
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 )
 
+// typeAheadTimeout is the pause after which type-ahead input starts over.
+const typeAheadTimeout = time.Second
+
 // singleSelect renders an interactive single-selection prompt.
 // Construct one with [Select].
 type singleSelect struct {
@@ -17,12 +23,41 @@ type singleSelect struct {
 	prefix          string
 	label           string
 	choices         []Choice
+	sortLess        func(a, b Choice) bool
 	preSelected     *string
 	cursorIndicator string
 	selectionMarker string
 	pageSize        int
+	scrollOff       int
 	selectedChoice  Choice
 	validator       func(Choice) (string, bool)
+	keyHandler      func(Key) (handled, stop bool)
+	submitKey       KeyCode
+	echoFormat      string
+	onHighlight     func(Choice)
+	bell            bool
+	showPosition    bool
+	maxAttempts     int
+	emptyMessage    string
+	maxLabelWidth   int
+	showValues      bool
+	searchValues    bool
+	verticalOnly    bool
+	noSearch        bool
+	initialSearch   string
+	retainSearch    bool
+	retained        *retainedSearch
+	compactHelp     bool
+	rtl             bool
+	scrollbar       bool
+	mouse           bool
+	boxWidth        int
+	typeAhead       bool
+	hidePrefix      bool
+	showRequired    bool
+	beforeRender    func(io.Writer)
+	afterRender     func(io.Writer)
+	onInterrupt     func()
 }
 
 // Select returns a builder for an interactive single-selection prompt.
@@ -37,6 +72,7 @@ func Select() *singleSelect {
 		cursorIndicator: ">",
 		selectionMarker: "*",
 		pageSize:        10,
+		submitKey:       KeyEnter,
 	}
 }
 
@@ -52,6 +88,27 @@ func (s *singleSelect) WithPrefix(p string) *singleSelect {
 	return s
 }
 
+// WithPrefixHidden removes the prompt prefix and the space after it.
+func (s *singleSelect) WithPrefixHidden() *singleSelect {
+	s.hidePrefix = true
+	return s
+}
+
+// WithRequiredMarker shows a "*" after the label to mark the prompt as
+// required. The marker is omitted while the prompt has a preselected choice,
+// since submitting without input is then allowed.
+func (s *singleSelect) WithRequiredMarker() *singleSelect {
+	s.showRequired = true
+	return s
+}
+
+// WithIconFallback sets the prefix to emoji, or to ascii on terminals
+// and locales where emoji are unlikely to render correctly.
+func (s *singleSelect) WithIconFallback(emoji, ascii string) *singleSelect {
+	s.prefix = iconFor(emoji, ascii)
+	return s
+}
+
 // WithLabel sets the prompt label shown to the user.
 func (s *singleSelect) WithLabel(l string) *singleSelect {
 	s.label = l
@@ -60,29 +117,56 @@ func (s *singleSelect) WithLabel(l string) *singleSelect {
 
 // WithChoices sets the list of choices available for selection.
 func (s *singleSelect) WithChoices(ch []Choice) *singleSelect {
-	s.choices = ch
+	s.choices = sortChoices(ch, s.sortLess)
+	return s
+}
+
+// WithSortChoices displays the choices ordered by less, whether they are
+// set before or after this call. The caller's slice is left untouched, and
+// defaults still resolve by value.
+func (s *singleSelect) WithSortChoices(less func(a, b Choice) bool) *singleSelect {
+	s.sortLess = less
+	s.choices = sortChoices(s.choices, less)
 	return s
 }
 
-// WithSelectedChoice pre-selects a choice by its value.
+// WithSortByLabel displays the choices ordered by label, ignoring case.
+func (s *singleSelect) WithSortByLabel() *singleSelect {
+	return s.WithSortChoices(byLabel)
+}
+
+// WithSelectedChoice pre-selects a choice by its value. The choice is marked
+// with a dimmed "(default)" hint so users can tell where they started.
 func (s *singleSelect) WithSelectedChoice(value string) *singleSelect {
 	s.preSelected = &value
 	return s
 }
 
-// WithPageSize sets the number of choices visible at once.
+// WithPageSize sets the number of choices visible at once (minimum 1).
+// The page shrinks to fit when the terminal is too short to show n choices.
 func (s *singleSelect) WithPageSize(n int) *singleSelect {
-	s.pageSize = n
+	s.pageSize = max(1, n)
+	return s
+}
+
+// WithScrollOff keeps the cursor at least n rows away from the top and
+// bottom of the page while scrolling, like vim's scrolloff, so the list
+// moves around the cursor. n is capped at half the page; a large value
+// keeps the cursor centered. Defaults to 0, which scrolls only at the edge.
+func (s *singleSelect) WithScrollOff(n int) *singleSelect {
+	s.scrollOff = max(0, n)
 	return s
 }
 
 // WithCursorIndicator overrides the cursor indicator symbol.
+// An empty or zero-width indicator is rendered as a single space.
 func (s *singleSelect) WithCursorIndicator(ind string) *singleSelect {
 	s.cursorIndicator = ind
 	return s
 }
 
 // WithSelectionMarker overrides the selection marker symbol.
+// An empty or zero-width marker is rendered as a single space.
 func (s *singleSelect) WithSelectionMarker(mrk string) *singleSelect {
 	s.selectionMarker = mrk
 	return s
@@ -95,19 +179,224 @@ func (s *singleSelect) WithValidator(v func(Choice) (string, bool)) *singleSelec
 	return s
 }
 
+// WithKeyHandler installs a hook consulted before the prompt's own key
+// handling. Returning handled skips the default action for that key;
+// returning stop exits the prompt with [ErrStopped]. Ignored in accessible mode.
+//
+//	asky.Select().WithKeyHandler(func(k asky.Key) (handled, stop bool) {
+//	    return k.Code == asky.KeyF1, k.Code == asky.KeyF1 // exit to show help
+//	})
+func (s *singleSelect) WithKeyHandler(fn func(k Key) (handled, stop bool)) *singleSelect {
+	s.keyHandler = fn
+	return s
+}
+
+// WithSubmitKey rebinds the key that confirms the selection from Enter to
+// code, e.g. [KeyCtrlS] for terminals where Enter is unreliable. Enter is
+// then ignored, and the help line names the new key. Ignored in
+// accessible mode.
+func (s *singleSelect) WithSubmitKey(code KeyCode) *singleSelect {
+	s.submitKey = code
+	return s
+}
+
+// WithEchoOnSubmit replaces the prompt, once a choice is confirmed, with a
+// single line built from format. Its {label} placeholder is filled with
+// the prompt label, {value} with the chosen [Choice.Value] and {choice}
+// with the chosen [Choice.Label]. Ignored in accessible mode, where the
+// answer is already part of the transcript.
+//
+//	.WithEchoOnSubmit("✓ {label}: {choice}")
+func (s *singleSelect) WithEchoOnSubmit(format string) *singleSelect {
+	s.echoFormat = format
+	return s
+}
+
+// WithOnHighlight sets fn to run whenever the cursor lands on a different
+// choice, including the first one drawn, e.g. to render a preview of the
+// item elsewhere on screen. fn runs on the input goroutine after the frame
+// is drawn, so slow work should be handed off. Ignored in accessible mode.
+func (s *singleSelect) WithOnHighlight(fn func(c Choice)) *singleSelect {
+	s.onHighlight = fn
+	return s
+}
+
+// WithBell rings the terminal bell when an action is rejected, such as
+// a failed validation on submit or selecting from an empty list.
+func (s *singleSelect) WithBell() *singleSelect {
+	s.bell = true
+	return s
+}
+
+// WithPositionIndicator shows the cursor position within the filtered list
+// (e.g. "12/340") on the search line.
+func (s *singleSelect) WithPositionIndicator() *singleSelect {
+	s.showPosition = true
+	return s
+}
+
+// WithMaxAttempts gives up with [ErrTooManyAttempts] once the validator has
+// rejected n submissions. Zero (the default) allows unlimited attempts.
+func (s *singleSelect) WithMaxAttempts(n int) *singleSelect {
+	s.maxAttempts = n
+	return s
+}
+
+// WithEmptyMessage sets a message shown in place of the choice list when
+// the search query matches nothing, e.g. "No matching choices".
+func (s *singleSelect) WithEmptyMessage(msg string) *singleSelect {
+	s.emptyMessage = msg
+	return s
+}
+
+// WithMaxLabelWidth truncates choice labels wider than n columns with an
+// ellipsis. Zero (the default) only truncates at the terminal width.
+// Search still matches against the full label.
+func (s *singleSelect) WithMaxLabelWidth(n int) *singleSelect {
+	s.maxLabelWidth = max(0, n)
+	return s
+}
+
+// WithShowValues draws each choice's value dimmed in parentheses after its
+// label, e.g. "Production (prod)", for menus where labels are ambiguous
+// but values are canonical. Values are not searched unless
+// WithSearchIncludeValue is also set.
+func (s *singleSelect) WithShowValues() *singleSelect {
+	s.showValues = true
+	return s
+}
+
+// WithSearchIncludeValue makes the search match choice values as well as
+// labels.
+func (s *singleSelect) WithSearchIncludeValue() *singleSelect {
+	s.searchValues = true
+	return s
+}
+
+// WithVerticalOnly stops the h/l keys from moving the cursor, leaving only
+// the vertical keys (↑/↓ and j/k) for navigation. Left and Right arrows
+// never move the cursor.
+func (s *singleSelect) WithVerticalOnly() *singleSelect {
+	s.verticalOnly = true
+	return s
+}
+
+// WithSearchDisabled removes the search line and its hint and turns off
+// Tab-to-search, for short lists where searching adds only clutter.
+func (s *singleSelect) WithSearchDisabled() *singleSelect {
+	s.noSearch = true
+	return s
+}
+
+// WithInitialSearch opens the prompt in search mode with q already typed,
+// so the list starts filtered. Ignored with WithSearchDisabled and in
+// accessible mode.
+func (s *singleSelect) WithInitialSearch(q string) *singleSelect {
+	s.initialSearch = q
+	return s
+}
+
+// WithRetainSearch keeps the search query, and whether search mode was
+// on, from one Render to the next on the same prompt, so a select shown
+// in a loop reopens with the user's filter. It takes precedence over
+// WithInitialSearch after the first Render.
+func (s *singleSelect) WithRetainSearch() *singleSelect {
+	s.retainSearch = true
+	return s
+}
+
+// WithCompactHelp folds the help footer into a single line, leaving one
+// more row for choices on short terminals.
+func (s *singleSelect) WithCompactHelp() *singleSelect {
+	s.compactHelp = true
+	return s
+}
+
+// WithRTL right-aligns the choice rows to the list width, for Arabic,
+// Hebrew and other right-to-left labels. Labels are not reordered; the
+// terminal remains responsible for bidi display.
+func (s *singleSelect) WithRTL() *singleSelect {
+	s.rtl = true
+	return s
+}
+
+// WithScrollbar draws a scrollbar to the right of the choices, whose thumb
+// shows the size and position of the visible page within the filtered
+// list. It takes 2 columns from the list and is blank while every choice
+// fits on the page.
+func (s *singleSelect) WithScrollbar() *singleSelect {
+	s.scrollbar = true
+	return s
+}
+
+// WithMouse turns on mouse reporting while the prompt is active, so the
+// scroll wheel moves the cursor like the arrow keys. Most terminals only
+// allow text selection with Shift held while reporting is on. Ignored in
+// accessible mode.
+func (s *singleSelect) WithMouse() *singleSelect {
+	s.mouse = true
+	return s
+}
+
+// WithWidth constrains the choice list to n columns, including a border
+// drawn around it. Rows are padded or truncated to fit, and n is capped at
+// the terminal width. Zero (the default) draws no border.
+func (s *singleSelect) WithWidth(n int) *singleSelect {
+	s.boxWidth = max(0, n)
+	return s
+}
+
+// WithTypeAhead jumps the cursor to the first choice whose label starts
+// with the letters typed while navigating, like a native list box. Typing
+// pauses of more than a second start a new match. The j/k/h/l keys are
+// typed rather than used for navigation; Tab-search is unaffected.
+func (s *singleSelect) WithTypeAhead() *singleSelect {
+	s.typeAhead = true
+	return s
+}
+
+// WithBeforeRender sets fn to run once before the prompt is drawn, with
+// the output writer, e.g. to print a header above the prompt.
+func (s *singleSelect) WithBeforeRender(fn func(w io.Writer)) *singleSelect {
+	s.beforeRender = fn
+	return s
+}
+
+// WithAfterRender sets fn to run once after the prompt is answered or
+// cancelled and its frame cleared, with the output writer.
+func (s *singleSelect) WithAfterRender(fn func(w io.Writer)) *singleSelect {
+	s.afterRender = fn
+	return s
+}
+
+// WithInterruptHandler sets fn to run when Ctrl+C cancels the prompt, just
+// before Render returns [ErrInterrupted], e.g. to save a draft.
+func (s *singleSelect) WithInterruptHandler(fn func()) *singleSelect {
+	s.onInterrupt = fn
+	return s
+}
+
 // Render displays the prompt and blocks until the user confirms or cancels.
 // Returns the selected [Choice], or [ErrInterrupted] if Ctrl+C is pressed.
 //
 // In accessible mode, choices are printed as a numbered list and the user
 // types the index. In interactive mode, choices are navigated with arrow keys.
-func (s *singleSelect) Render() (Choice, error) {
+func (s *singleSelect) Render() (_ Choice, err error) {
 	if len(s.choices) == 0 {
 		return Choice{}, ErrNoSelectionChoices
 	}
+	runHook(s.beforeRender)
+	defer runHook(s.afterRender)
+	defer runInterruptHandler(s.onInterrupt, &err)
+
 	if s.cfg.Accessible {
 		return s.renderAccessible()
 	}
-	return s.renderInteractive()
+	choice, err := s.renderInteractive()
+	if err == nil && s.echoFormat != "" {
+		echoAnswer(s.echoFormat, s.cfg.Styles, "{label}", s.label, "{value}", choice.Value, "{choice}", choice.Label)
+	}
+	return choice, err
 }
 
 // renderAccessible prints a numbered list and collects the user's choice by index.
@@ -115,17 +404,24 @@ func (s *singleSelect) Render() (Choice, error) {
 func (s *singleSelect) renderAccessible() (Choice, error) {
 
 	// Print the header
-	prefix := pick(s.prefix, "(?)")
+	prefix := promptPrefix(s.prefix, s.hidePrefix, s.cfg.Styles.SelectionPrefix)
 	stdOutput.Write([]byte(
-		safeStyle(s.cfg.Styles.SelectionPrefix).Sprint(prefix+" ") +
-			safeStyle(s.cfg.Styles.SelectionLabel).Sprint(s.label) + "\n",
+		prefix +
+			safeStyle(s.cfg.Styles.SelectionLabel).Sprint(s.label) + s.requiredMarker() + "\n",
 	))
 
 	// Print numbered choices
 	width := len(strconv.Itoa(len(s.choices)))
 	for i, c := range s.choices {
 		num := safeStyle(s.cfg.Styles.SelectionSearchHint).Sprintf("%*d. ", width, i+1)
-		label := safeStyle(s.cfg.Styles.SelectionItemNormalLabel).Sprint(c.Label)
+		labelStyle := s.cfg.Styles.SelectionItemNormalLabel
+		if c.Color != nil {
+			labelStyle = c.Color
+		}
+		label := safeStyle(labelStyle).Sprint(c.Label)
+		if s.showValues {
+			label += safeStyle(s.cfg.Styles.SelectionItemDefaultHint).Sprint(" (" + c.Value + ")")
+		}
 		stdOutput.Write([]byte("  " + num + label + "\n"))
 	}
 
@@ -139,9 +435,10 @@ func (s *singleSelect) renderAccessible() (Choice, error) {
 			}
 		}
 	}
-	promptStr := safeStyle(s.cfg.Styles.SelectionPrefix).Sprint(prefix) + " " +
+	promptStr := prefix +
 		safeStyle(s.cfg.Styles.SelectionLabel).Sprintf("Choose between 1 and %d%s: ", len(s.choices), hint)
 
+	attempts := 0
 	for {
 		stdOutput.Write([]byte(promptStr))
 
@@ -183,7 +480,14 @@ func (s *singleSelect) renderAccessible() (Choice, error) {
 					if c.Value == *s.preSelected {
 						if s.validator != nil {
 							if msg, ok := s.validator(c); !ok {
+								if s.bell {
+									bell()
+								}
 								stdOutput.Write([]byte(safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(msg) + "\n"))
+								attempts++
+								if s.maxAttempts > 0 && attempts >= s.maxAttempts {
+									return Choice{}, ErrTooManyAttempts
+								}
 								continue
 							}
 						}
@@ -191,6 +495,9 @@ func (s *singleSelect) renderAccessible() (Choice, error) {
 					}
 				}
 			}
+			if s.bell {
+				bell()
+			}
 			stdOutput.Write([]byte(safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint("please enter a number") + "\n"))
 			continue
 		}
@@ -198,6 +505,9 @@ func (s *singleSelect) renderAccessible() (Choice, error) {
 		// Parse number
 		n, err := strconv.Atoi(line)
 		if err != nil || n < 1 || n > len(s.choices) {
+			if s.bell {
+				bell()
+			}
 			stdOutput.Write([]byte(
 				safeStyle(s.cfg.Styles.SelectionValidationFail).
 					Sprintf("enter a number between 1 and %d\n", len(s.choices)),
@@ -209,7 +519,14 @@ func (s *singleSelect) renderAccessible() (Choice, error) {
 
 		if s.validator != nil {
 			if msg, ok := s.validator(chosen); !ok {
+				if s.bell {
+					bell()
+				}
 				stdOutput.Write([]byte(safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(msg) + "\n"))
+				attempts++
+				if s.maxAttempts > 0 && attempts >= s.maxAttempts {
+					return Choice{}, ErrTooManyAttempts
+				}
 				continue
 			}
 		}
@@ -227,14 +544,23 @@ func (s *singleSelect) renderInteractive() (Choice, error) {
 	)
 	var (
 		interrupted     = false
+		stopped         = false
+		exhausted       = false
+		attempts        = 0
 		searchQuery     = ""
 		searchMode      = false
 		filteredChoices = s.choices
-		nav             = &selectionNav{}
+		nav             = &selectionNav{scrollOff: s.scrollOff}
 		valMessage      = ""
 		prevHeight      = 0
+		highlighted     *Choice
+		typed           = ""
+		lastTyped       time.Time
 	)
 
+	// Open with the initial search applied, if any
+	searchQuery, searchMode, filteredChoices = s.initialSearchState()
+
 	// Initialize navigation
 	nav.reset(len(filteredChoices), min(s.pageSize, len(filteredChoices)))
 
@@ -243,71 +569,10 @@ func (s *singleSelect) renderInteractive() (Choice, error) {
 		return Choice{}, ErrTerminalTooSmall
 	}
 
-	// Build the header lines
-	promptLine := safeStyle(s.cfg.Styles.SelectionPrefix).Sprint(pick(s.prefix, "(?)")) + " " +
-		safeStyle(s.cfg.Styles.SelectionLabel).Sprint(s.label)
-	searchLabel := safeStyle(s.cfg.Styles.SelectionSearchLabel).Sprint("Search: ")
-	headerLines := []string{promptLine, ""}
-
 	// Selection Prompt Renderer
 	redraw := func() {
 		newW, newH, _ := termSize()
-
-		// Build the current search line
-		searchLine := searchLabel + safeStyle(s.cfg.Styles.SelectionSearchText).Sprint(searchQuery)
-		if searchMode {
-			searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" • " + strconv.Itoa(len(filteredChoices)) + " hits")
-		}
-		if s.selectedChoice != (Choice{}) {
-			searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" (1 selected)")
-		} else {
-			searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" (0 selected)")
-		}
-
-		// Update the header lines & compute the frame height for header
-		headerLines[1] = searchLine
-		headerLinesHeight := totalPhysicalLines(headerLines, newW)
-
-		// Build the footer lines & compute the frame height for footer
-		footerLines := []string{""}
-		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(valMessage))
-		if searchMode {
-			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("↑/↓ move • space select • enter confirm"))
-			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("type to search (esc/tab nav)"))
-		} else {
-			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("↑/↓ move • space select • enter confirm"))
-			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("tab to search"))
-		}
-		footerLinesHeight := totalPhysicalLines(footerLines, newW)
-
-		// Compute page size & reset navigation if needed
-		pageSize := min(s.pageSize, len(filteredChoices), newH-headerLinesHeight-footerLinesHeight)
-		if pageSize != nav.pageSize && pageSize > 0 {
-			nav.reset(len(filteredChoices), pageSize)
-		}
-
-		// Build contentLines
-		var contentLines []string
-		contentLines = append(contentLines, headerLines...)
-
-		// Build content for the visible choices list & pad the rest with empty lines
-		for i := nav.startIdx; i < nav.endIdx; i++ {
-			contentLines = append(contentLines, renderSelectionChoice(
-				filteredChoices[i],
-				i == nav.cursorIdx,
-				filteredChoices[i].Value == s.selectedChoice.Value,
-				newW-1,
-				s.cursorIndicator,
-				s.selectionMarker,
-				s.cfg.Styles),
-			)
-		}
-
-		// Pad the rest to maintain consistent height
-		for i := nav.endIdx - nav.startIdx; i < nav.pageSize; i++ {
-			contentLines = append(contentLines, "")
-		}
-		contentLines = append(contentLines, footerLines...)
+		contentLines := s.frameLines(filteredChoices, nav, searchQuery, searchMode, valMessage, newW, newH)
 
 		// Compute new frame's physical height at current width
 		newHeight := totalPhysicalLines(contentLines, newW)
@@ -318,6 +583,9 @@ func (s *singleSelect) renderInteractive() (Choice, error) {
 				"\r" + ansiClearScreen +
 					safeStyle(s.cfg.Styles.SelectionItemCurrentMarker).Sprint("terminal too small to render content"),
 			))
+			// The cursor is now on the frame's first row; cleanup and the
+			// next frame must not move above it
+			prevHeight = 0
 			return
 		}
 
@@ -339,53 +607,82 @@ func (s *singleSelect) renderInteractive() (Choice, error) {
 
 		stdOutput.Write([]byte(b.String()))
 		prevHeight = newHeight - 1
-	}
 
-	// Apply default selection by value
-	if s.preSelected != nil {
-		for _, c := range s.choices {
-			if c.Value == *s.preSelected {
-				s.selectedChoice = c
-				break
+		// Report the highlighted choice only when it changes
+		if s.onHighlight != nil && len(filteredChoices) > 0 {
+			if cur := filteredChoices[nav.cursorIdx]; highlighted == nil || cur.Value != highlighted.Value {
+				highlighted = &cur
+				s.onHighlight(cur)
 			}
 		}
 	}
 
+	// Apply default selection by value
+	s.applyPreSelected()
+
 	// Prep for render, hide cursor, defer cleanup
 	stdOutput.Write([]byte("\r" + ansiHideCursor))
-	defer func() {
-		ansiCursorUp(prevHeight)
-		stdOutput.Write([]byte("\r" + ansiClearScreen + ansiReset + ansiShowCursor))
-	}()
+	defer func() { restoreTerminal(prevHeight) }()
+	if s.mouse {
+		stdOutput.Write([]byte(ansiMouseOn))
+		defer func() { stdOutput.Write([]byte(ansiMouseOff)) }()
+	}
 
 	// Initial render
 	redraw()
 
 	// Handle user input & redraw per keystroke
-	err := listenKeys(func(ev keyEvent) (stop bool) {
-		switch ev.code {
-		case keyCtrlC:
+	err := listenKeys(func(ev Key) (stop bool) {
+		if s.keyHandler != nil {
+			handled, exit := s.keyHandler(ev)
+			if exit {
+				stopped = true
+				return true
+			}
+			if handled {
+				return false
+			}
+		}
+
+		ev, ok := remapSubmitKey(ev, s.submitKey, KeyEnter)
+		if !ok {
+			return false
+		}
+
+		switch ev.Code {
+		case KeyCtrlC:
 			interrupted = true
 			return true
-		case keyUp:
+		case KeyUp, KeyWheelUp:
 			nav.up(len(filteredChoices))
-		case keyDown:
+		case KeyDown, KeyWheelDown:
 			nav.down(len(filteredChoices))
-		case keyTab:
-			searchMode = !searchMode
-		case keyEscape:
+		case KeyTab:
+			searchMode = !searchMode && !s.noSearch
+		case KeyEscape:
 			searchMode = false
-		case keyEnter:
+		case KeyEnter:
 			if s.validator != nil {
 				if msg, ok := s.validator(s.selectedChoice); !ok {
+					if s.bell {
+						bell()
+					}
 					valMessage = msg
+					attempts++
+					if s.maxAttempts > 0 && attempts >= s.maxAttempts {
+						exhausted = true
+						return true
+					}
 					break
 				}
 			}
 			return true
-		case keySpace:
+		case KeySpace:
 			if len(filteredChoices) == 0 {
-				valMessage = "no choices available"
+				if s.bell {
+					bell()
+				}
+				valMessage = msgNoChoices
 				break
 			}
 			cur := filteredChoices[nav.cursorIdx]
@@ -395,30 +692,53 @@ func (s *singleSelect) renderInteractive() (Choice, error) {
 				s.selectedChoice = cur
 			}
 			valMessage = ""
-		case keyBackspace:
+		case KeyBackspace:
 			if searchMode && len(searchQuery) > 0 {
 				searchQuery = searchQuery[:len(searchQuery)-1]
-				filteredChoices = filterSelectionChoices(s.choices, searchQuery)
+				filteredChoices = filterSelectionChoices(s.choices, searchQuery, s.searchValues)
 				nav.reset(len(filteredChoices), nav.pageSize)
 			}
-		case keyRune:
+		case KeyRune:
 			if searchMode {
-				searchQuery += string(ev.r)
-				filteredChoices = filterSelectionChoices(s.choices, searchQuery)
+				searchQuery += string(ev.Rune)
+				filteredChoices = filterSelectionChoices(s.choices, searchQuery, s.searchValues)
 				nav.reset(len(filteredChoices), nav.pageSize)
+			} else if s.typeAhead {
+				if time.Since(lastTyped) > typeAheadTimeout {
+					typed = ""
+				}
+				typed += strings.ToLower(string(ev.Rune))
+				lastTyped = time.Now()
+				if i := slices.IndexFunc(filteredChoices, func(c Choice) bool {
+					return strings.HasPrefix(strings.ToLower(c.Label), typed)
+				}); i >= 0 {
+					nav.moveTo(i, len(filteredChoices))
+				} else if s.bell {
+					bell()
+				}
 			} else {
-				switch ev.r {
-				case 'j', 'l':
+				switch {
+				case ev.Rune == 'j', ev.Rune == 'l' && !s.verticalOnly:
 					nav.down(len(filteredChoices))
-				case 'k', 'h':
+				case ev.Rune == 'k', ev.Rune == 'h' && !s.verticalOnly:
 					nav.up(len(filteredChoices))
 				}
 			}
 		}
+
+		// Once the search matches again, the empty-list warning is stale
+		if valMessage == msgNoChoices && len(filteredChoices) > 0 {
+			valMessage = ""
+		}
 		redraw()
 		return false
 	})
 
+	// Keep the search for the next Render
+	if s.retainSearch {
+		s.retained = &retainedSearch{query: searchQuery, mode: searchMode}
+	}
+
 	// Handle errors, edge cases, interrupts and return selected choice
 	if err != nil {
 		return Choice{}, err
@@ -426,5 +746,195 @@ func (s *singleSelect) renderInteractive() (Choice, error) {
 	if interrupted {
 		return Choice{}, ErrInterrupted
 	}
+	if stopped {
+		return Choice{}, ErrStopped
+	}
+	if exhausted {
+		return Choice{}, ErrTooManyAttempts
+	}
 	return s.selectedChoice, nil
 }
+
+// Preview returns the prompt's initial frame as it would first be drawn,
+// without reading input or moving the cursor. Useful for documentation
+// and layout debugging.
+func (s *singleSelect) Preview() string {
+	selected := s.selectedChoice
+	defer func() { s.selectedChoice = selected }()
+	s.applyPreSelected()
+
+	termW, termH := previewSize()
+	nav := &selectionNav{scrollOff: s.scrollOff}
+	query, searchMode, filtered := s.initialSearchState()
+	nav.reset(len(filtered), min(s.pageSize, len(filtered)))
+	return strings.Join(s.frameLines(filtered, nav, query, searchMode, "", termW, termH), "\n")
+}
+
+// initialSearchState returns the search query, search mode and filtered
+// choices the prompt opens with.
+func (s *singleSelect) initialSearchState() (string, bool, []Choice) {
+	if s.noSearch {
+		return "", false, s.choices
+	}
+	if s.retained != nil {
+		return s.retained.query, s.retained.mode, filterSelectionChoices(s.choices, s.retained.query, s.searchValues)
+	}
+	if s.initialSearch == "" {
+		return "", false, s.choices
+	}
+	return s.initialSearch, true, filterSelectionChoices(s.choices, s.initialSearch, s.searchValues)
+}
+
+// DebugState returns the prompt's resolved configuration on one line, for
+// logging when it renders unexpectedly: whether its styles are the
+// built-in defaults, those set with [Configure], or its own from
+// WithStyles, the accessibility and color switches, and its main options.
+func (s *singleSelect) DebugState() string {
+	return debugState("select", s.cfg,
+		"label", s.label,
+		"choices", len(s.choices),
+		"pageSize", s.pageSize,
+		"search", !s.noSearch,
+		"validator", s.validator != nil)
+}
+
+// applyPreSelected selects the choice set with [singleSelect.WithSelectedChoice], if any.
+func (s *singleSelect) applyPreSelected() {
+	if s.preSelected == nil {
+		return
+	}
+	for _, c := range s.choices {
+		if c.Value == *s.preSelected {
+			s.selectedChoice = c
+			break
+		}
+	}
+}
+
+// requiredMarker returns the styled required marker, or "" when hidden.
+func (s *singleSelect) requiredMarker() string {
+	if !s.showRequired || s.preSelected != nil {
+		return ""
+	}
+	return safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint("*")
+}
+
+// frameLines builds the lines of one frame for a terminal of termW x termH:
+// the header, the visible page of filtered choices padded to the page size,
+// and the footer. nav is resized in place when the page size changes.
+func (s *singleSelect) frameLines(filteredChoices []Choice, nav *selectionNav, searchQuery string, searchMode bool, valMessage string, termW, termH int) []string {
+	// Build the header lines
+	promptLine := promptPrefix(s.prefix, s.hidePrefix, s.cfg.Styles.SelectionPrefix) +
+		safeStyle(s.cfg.Styles.SelectionLabel).Sprint(s.label) + s.requiredMarker()
+	searchLabel := safeStyle(s.cfg.Styles.SelectionSearchLabel).Sprint("Search: ")
+
+	// Build the current search line
+	searchLine := searchLabel + safeStyle(s.cfg.Styles.SelectionSearchText).Sprint(searchQuery)
+	if searchMode {
+		searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" • " + strconv.Itoa(len(filteredChoices)) + " hits")
+	}
+	if s.selectedChoice != (Choice{}) {
+		searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" (1 selected)")
+	} else {
+		searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" (0 selected)")
+	}
+
+	if s.showPosition {
+		pos := 0
+		if len(filteredChoices) > 0 {
+			pos = nav.cursorIdx + 1
+		}
+		searchLine += safeStyle(s.cfg.Styles.SelectionSearchHint).Sprint(" • " + strconv.Itoa(pos) + "/" + strconv.Itoa(len(filteredChoices)))
+	}
+
+	// Compute the frame height for header
+	headerLines := []string{promptLine, searchLine}
+	if s.noSearch {
+		headerLines = headerLines[:1]
+	}
+	headerLinesHeight := totalPhysicalLines(headerLines, termW)
+
+	// Build the footer lines & compute the frame height for footer
+	footerLines := []string{""}
+	footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionValidationFail).Sprint(valMessage))
+	switch {
+	case s.compactHelp:
+		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint(compactSelectionHelp("↑/↓ move", "select", keyLabel(s.submitKey), searchMode, s.noSearch)))
+	case searchMode:
+		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("↑/↓ move • space select • "+keyLabel(s.submitKey)+" confirm"))
+		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("type to search (esc/tab nav)"))
+	default:
+		footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("↑/↓ move • space select • "+keyLabel(s.submitKey)+" confirm"))
+		if !s.noSearch {
+			footerLines = append(footerLines, safeStyle(s.cfg.Styles.SelectionHelp).Sprint("tab to search"))
+		}
+	}
+	footerLinesHeight := totalPhysicalLines(footerLines, termW)
+
+	// Compute page size & reset navigation if needed
+	listW, boxRows := termW-1, 0
+	if s.boxWidth > 0 {
+		listW, boxRows = max(min(s.boxWidth, termW-1)-4, 1), 2
+	}
+	if s.scrollbar {
+		listW = max(listW-2, 1)
+	}
+	listRows := termH - headerLinesHeight - footerLinesHeight - boxRows
+	pageSize := min(s.pageSize, len(filteredChoices), listRows)
+	if pageSize != nav.pageSize && pageSize > 0 {
+		nav.reset(len(filteredChoices), pageSize)
+	}
+
+	// Build rows for the visible choices list & pad the rest with empty lines
+	var listLines []string
+	for i := nav.startIdx; i < nav.endIdx; i++ {
+		choice := filteredChoices[i]
+		if s.maxLabelWidth > 0 {
+			choice.Label = TruncToWidth(choice.Label, s.maxLabelWidth)
+		}
+		listLines = append(listLines, renderSelectionChoice(
+			choice,
+			searchQuery,
+			i == nav.cursorIdx,
+			filteredChoices[i].Value == s.selectedChoice.Value,
+			false,
+			choiceHint(choice, s.showValues, s.preSelected != nil && filteredChoices[i].Value == *s.preSelected),
+			listW,
+			s.cursorIndicator,
+			s.selectionMarker,
+			s.cfg.Styles),
+		)
+	}
+
+	// Show the empty message in the first row when nothing matches
+	padFrom := nav.endIdx - nav.startIdx
+	if len(filteredChoices) == 0 && s.emptyMessage != "" && listRows > 0 {
+		listLines = append(listLines, renderSelectionEmpty(
+			s.emptyMessage,
+			listW,
+			s.cursorIndicator,
+			s.selectionMarker,
+			s.cfg.Styles),
+		)
+		padFrom++
+	}
+
+	if s.rtl {
+		listLines = alignSelectionRows(listLines, listW)
+	}
+
+	// Pad the rest to maintain consistent height
+	for i := padFrom; i < nav.pageSize; i++ {
+		listLines = append(listLines, "")
+	}
+	if s.scrollbar {
+		listLines = appendScrollbar(listLines, listW, nav.startIdx, len(filteredChoices), s.cfg.Styles.SelectionHelp)
+		listW += 2
+	}
+	if s.boxWidth > 0 {
+		listLines = boxSelectionRows(listLines, listW, s.cfg.Styles.SelectionHelp)
+	}
+
+	contentLines := append(headerLines, listLines...)
+	return append(contentLines, footerLines...)
+}
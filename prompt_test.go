@@ -0,0 +1,277 @@
+package asky
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+func TestRenderErrorPathsWriteNothing(t *testing.T) {
+	if term.IsTerminal(int(os.Stdout.Fd())) {
+		t.Skip("stdout is a terminal, so the prompts would wait for input")
+	}
+	choices := ChoicesFromStrings([]string{"a", "b"})
+	tests := []struct {
+		name   string
+		render func() error
+		want   error
+	}{
+		{"text", func() error { _, err := Text().Render(); return err }, ErrTerminalTooSmall},
+		{"secret", func() error { _, err := Secret().Render(); return err }, ErrTerminalTooSmall},
+		{"multiline", func() error { _, err := MultilineText().Render(); return err }, ErrTerminalTooSmall},
+		{"confirm", func() error { _, err := Confirm().Render(); return err }, ErrTerminalTooSmall},
+		{"select", func() error { _, err := Select().WithChoices(choices).Render(); return err }, ErrTerminalTooSmall},
+		{"multiselect", func() error { _, err := MultiSelect().WithChoices(choices).Render(); return err }, ErrTerminalTooSmall},
+		{"select without choices", func() error { _, err := Select().Render(); return err }, ErrNoSelectionChoices},
+		{"multiselect without choices", func() error { _, err := MultiSelect().Render(); return err }, ErrNoSelectionChoices},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			SetOutput(&buf)
+			defer SetOutput(nil)
+
+			if err := tt.render(); !errors.Is(err, tt.want) {
+				t.Fatalf("Render() error = %v, want %v", err, tt.want)
+			}
+			if buf.Len() > 0 {
+				t.Errorf("Render() left %q on the terminal", buf.String())
+			}
+		})
+	}
+}
+
+func TestRenderSelectionChoiceEmptyMarkersAlign(t *testing.T) {
+	tests := []struct {
+		name            string
+		cursorIndicator string
+		selectionMarker string
+		wantCol         int // empty markers keep a one-column slot
+	}{
+		{"empty cursor indicator", "", "✓", 3},
+		{"empty selection marker", ">", "", 3},
+		{"both empty", "", "", 3},
+		{"wide marker", "", "✅", 4},
+	}
+	states := []struct{ cur, sel bool }{{false, false}, {true, false}, {false, true}, {true, true}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rows []string
+			for _, st := range states {
+				rows = append(rows, renderSelectionChoice(Choice{Label: "item"}, "", st.cur, st.sel, false, "",
+					40, tt.cursorIndicator, tt.selectionMarker, NewStyles()))
+			}
+
+			// The label starts in the same column whatever the row state
+			for i, row := range rows {
+				plain := stripAnsi(row)
+				if col := displayWidth(plain[:strings.Index(plain, "item")]); col != tt.wantCol {
+					t.Errorf("row %d (%+v) label at column %d, want %d: %q", i, states[i], col, tt.wantCol, plain)
+				}
+			}
+
+			// Right-aligned rows end on the same column
+			for i, row := range alignSelectionRows(rows, 20) {
+				if w := displayWidth(stripAnsi(row)); w != 20 {
+					t.Errorf("aligned row %d is %d columns wide, want 20: %q", i, w, stripAnsi(row))
+				}
+			}
+		})
+	}
+}
+
+func TestSelectionFrameFitsShortTerminal(t *testing.T) {
+	labels := make([]string, 100)
+	for i := range labels {
+		labels[i] = fmt.Sprintf("choice %d", i)
+	}
+	choices := ChoicesFromStrings(labels)
+	frames := []struct {
+		name  string
+		lines func(termW, termH int) []string
+	}{
+		{"select", func(termW, termH int) []string {
+			s := Select().WithChoices(choices).WithPageSize(50)
+			nav := &selectionNav{}
+			nav.reset(len(choices), min(s.pageSize, len(choices)))
+			return s.frameLines(choices, nav, "", false, "", termW, termH)
+		}},
+		{"multiselect", func(termW, termH int) []string {
+			s := MultiSelect().WithChoices(choices).WithPageSize(50)
+			nav := &selectionNav{}
+			nav.reset(len(choices), min(s.pageSize, len(choices)))
+			return s.frameLines(choices, nav, "", false, "", termW, termH)
+		}},
+	}
+	sizes := []struct{ termW, termH int }{{80, 12}, {80, 24}, {60, 40}}
+	for _, f := range frames {
+		for _, sz := range sizes {
+			t.Run(fmt.Sprintf("%s %dx%d", f.name, sz.termW, sz.termH), func(t *testing.T) {
+				lines := f.lines(sz.termW, sz.termH)
+				if h := totalPhysicalLines(lines, sz.termW); h > sz.termH {
+					t.Errorf("frame is %d rows tall on a %d-row terminal", h, sz.termH)
+				}
+				if !strings.Contains(stripAnsi(strings.Join(lines, "\n")), "choice 0") {
+					t.Error("frame does not list the first choice")
+				}
+			})
+		}
+	}
+}
+
+func TestMultiSelectMessageClearsBelowMax(t *testing.T) {
+	const maxSelected = 2
+	keys := []struct {
+		key         string
+		unavailable bool // whether the frame drawn after key shows the message
+	}{
+		{" ", false}, // select a
+		{"j", false},
+		{" ", false}, // select b, reaching the max
+		{"j", false},
+		{" ", true}, // c is refused
+		{"k", true},
+		{" ", false}, // deselect b
+		{"j", false},
+		{" ", false}, // select c, back at the max
+	}
+	out, in := fakeTerminal(t)
+
+	mostSelected := 0
+	s := MultiSelect().
+		WithChoices(ChoicesFromStrings([]string{"a", "b", "c", "d"})).
+		WithDependencyRule(func(selected []Choice, _ Choice) bool { return len(selected) < maxSelected })
+	s.WithKeyHandler(func(Key) (bool, bool) {
+		mostSelected = max(mostSelected, len(s.selectedChoices))
+		return false, false
+	})
+
+	var input strings.Builder
+	for _, k := range keys {
+		input.WriteString(k.key)
+	}
+	go in.Write([]byte(input.String() + "\r"))
+
+	got, err := s.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if mostSelected > maxSelected {
+		t.Errorf("%d choices were selected at once, want at most %d", mostSelected, maxSelected)
+	}
+	if len(got) != 2 || got[0].Value != "a" || got[1].Value != "c" {
+		t.Errorf("Render() = %v, want a and c", got)
+	}
+
+	// Each redraw ends by clearing below the frame; frame 0 is the first draw
+	frames := strings.Split(out.String(), ansiClearScreen)
+	for i, k := range keys {
+		frame := stripAnsi(frames[i+1])
+		if shown := strings.Contains(frame, "unavailable"); shown != k.unavailable {
+			t.Errorf("after key %d (%q) message shown = %v, want %v: %q", i, k.key, shown, k.unavailable, frame)
+		}
+	}
+}
+
+func TestRenderSelectionChoiceWideMarkersGolden(t *testing.T) {
+	tests := []struct {
+		name               string
+		cur, sel, disabled bool
+		want               string // every state puts the label at column 5
+	}{
+		{"normal", false, false, false, "     item"},
+		{"current", true, false, false, "👉   item"},
+		{"selected", false, true, false, "  ✔️ item"},
+		{"current and selected", true, true, false, "👉✔️ item"},
+		{"disabled", false, false, true, "     item"},
+		{"current and disabled", true, false, true, "👉   item"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := stripAnsi(renderSelectionChoice(Choice{Label: "item"}, "", tt.cur, tt.sel, tt.disabled, "",
+				40, "👉", "✔️", NewStyles()))
+			if row != tt.want {
+				t.Errorf("row = %q, want %q", row, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiSelectConfirmNamesSubmitKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		submit KeyCode
+		press  string
+		want   string
+	}{
+		{"enter", KeyEnter, "\r", "press enter again to confirm 1 selections"},
+		{"ctrl+s", KeyCtrlS, "\x13", "press ctrl+s again to confirm 1 selections"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, in := fakeTerminal(t)
+			s := MultiSelect().
+				WithChoices(ChoicesFromStrings([]string{"a", "b"})).
+				WithSubmitKey(tt.submit).
+				WithConfirmBeforeSubmit("")
+			go in.Write([]byte(" " + tt.press + tt.press))
+
+			if _, err := s.Render(); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := stripAnsi(out.String()); !strings.Contains(got, tt.want) {
+				t.Errorf("output does not show %q: %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRenderSelectionChoiceColorOnNormalRowsOnly(t *testing.T) {
+	red := color.New(color.FgRed)
+	red.EnableColor()
+	c := Choice{Label: "drop", Color: red}
+	tests := []struct {
+		name     string
+		cur, sel bool
+		tinted   bool
+	}{
+		{"normal", false, false, true},
+		{"current", true, false, false},
+		{"selected", false, true, false},
+		{"current and selected", true, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := renderSelectionChoice(c, "", tt.cur, tt.sel, false, "", 40, ">", "*", NewStyles())
+			if got := strings.Contains(row, red.Sprint("drop")); got != tt.tinted {
+				t.Errorf("label tinted = %v, want %v: %q", got, tt.tinted, row)
+			}
+		})
+	}
+}
+
+func TestRenderSelectionChoiceDisabledOverridesCursor(t *testing.T) {
+	styles := NewStyles()
+	styles.SelectionItemDisabledLabel = color.New(color.FgHiBlack)
+	styles.SelectionItemDisabledLabel.EnableColor()
+	styles.SelectionItemCurrentLabel = color.New(color.FgCyan)
+	styles.SelectionItemCurrentLabel.EnableColor()
+	red := color.New(color.FgRed)
+	red.EnableColor()
+
+	for _, cur := range []bool{false, true} {
+		row := renderSelectionChoice(Choice{Label: "item", Color: red}, "", cur, false, true, "", 40, ">", "*", styles)
+		if !strings.Contains(row, styles.SelectionItemDisabledLabel.Sprint("item")) {
+			t.Errorf("cur=%v: label not in the disabled style: %q", cur, row)
+		}
+		if cur && !strings.Contains(stripAnsi(row), ">") {
+			t.Errorf("cur=%v: cursor indicator missing: %q", cur, row)
+		}
+	}
+}
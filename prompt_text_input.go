@@ -2,11 +2,14 @@ package asky
 
 import (
 	"bufio"
+	"errors"
+	"io"
 	"os"
 	"os/signal"
 	"slices"
 	"strings"
 	"syscall"
+	"unicode/utf8"
 
 	"golang.org/x/term"
 )
@@ -30,6 +33,20 @@ type text struct {
 	defaultValue string
 	echo         EchoMode
 	validator    func(string) (string, bool)
+	keyHandler   func(Key) (handled, stop bool)
+	submitKey    KeyCode
+	echoFormat   string
+	bell         bool
+	cursorStyle  CursorStyle
+	hidePrefix   bool
+	showRequired bool
+	echoFn       func(string) string
+	conceal      bool
+	eofSubmit    bool
+	inline       bool
+	beforeRender func(io.Writer)
+	afterRender  func(io.Writer)
+	onInterrupt  func()
 }
 
 // secret renders an interactive single-line prompt for sensitive input.
@@ -44,9 +61,10 @@ type secret struct {
 //	if errors.Is(err, asky.ErrInterrupted) { ... }
 func Text() *text {
 	return &text{
-		cfg:   pkgConfig,
-		label: "Enter value",
-		echo:  echoNormal,
+		cfg:       pkgConfig,
+		label:     "Enter value",
+		echo:      echoNormal,
+		submitKey: KeyEnter,
 	}
 }
 
@@ -56,9 +74,10 @@ func Text() *text {
 //	pass, err := asky.Secret().WithLabel("Password").Render()
 func Secret() *secret {
 	return &secret{text{
-		cfg:   pkgConfig,
-		label: "Enter value",
-		echo:  EchoMask,
+		cfg:       pkgConfig,
+		label:     "Enter value",
+		echo:      EchoMask,
+		submitKey: KeyEnter,
 	}}
 }
 
@@ -74,6 +93,27 @@ func (t *text) WithPrefix(p string) *text {
 	return t
 }
 
+// WithPrefixHidden removes the prompt prefix and the space after it.
+func (t *text) WithPrefixHidden() *text {
+	t.hidePrefix = true
+	return t
+}
+
+// WithRequiredMarker shows a "*" after the label to mark the prompt as
+// required. The marker is omitted while the prompt has a default value,
+// since submitting without input is then allowed.
+func (t *text) WithRequiredMarker() *text {
+	t.showRequired = true
+	return t
+}
+
+// WithIconFallback sets the prefix to emoji, or to ascii on terminals
+// and locales where emoji are unlikely to render correctly.
+func (t *text) WithIconFallback(emoji, ascii string) *text {
+	t.prefix = iconFor(emoji, ascii)
+	return t
+}
+
 // WithLabel sets the prompt label shown to the user.
 func (t *text) WithLabel(l string) *text {
 	t.label = l
@@ -99,15 +139,124 @@ func (t *text) WithValidator(fn func(string) (string, bool)) *text {
 	return t
 }
 
+// WithKeyHandler installs a hook consulted before the prompt's own key
+// handling. Returning handled skips the default action for that key;
+// returning stop exits the prompt with [ErrStopped]. Ignored in accessible mode.
+func (t *text) WithKeyHandler(fn func(k Key) (handled, stop bool)) *text {
+	t.keyHandler = fn
+	return t
+}
+
+// WithSubmitKey rebinds the key that submits the prompt from Enter to
+// code, e.g. [KeyCtrlS] for terminals where Enter is unreliable. Enter is
+// then ignored, and the help line names the new key. Ignored in
+// accessible mode.
+func (t *text) WithSubmitKey(code KeyCode) *text {
+	t.submitKey = code
+	return t
+}
+
+// WithEchoOnSubmit replaces the prompt, once the input is submitted, with a
+// single line built from format, whose {label} and {value} placeholders are
+// filled with the prompt label and the answer as it was echoed while
+// typing. Ignored in accessible mode, where the answer is already part of
+// the transcript.
+//
+//	.WithEchoOnSubmit("✓ {label}: {value}")
+func (t *text) WithEchoOnSubmit(format string) *text {
+	t.echoFormat = format
+	return t
+}
+
+// WithBell rings the terminal bell when an action is rejected, such as
+// a failed validation on submit.
+func (t *text) WithBell() *text {
+	t.bell = true
+	return t
+}
+
+// WithCursorStyle sets the cursor shape shown while the prompt is active.
+// The terminal's default cursor is restored when the prompt exits.
+func (t *text) WithCursorStyle(style CursorStyle) *text {
+	t.cursorStyle = style
+	return t
+}
+
+// WithEOFSubmit makes Ctrl+D on an empty line submit the prompt like
+// Enter, applying the default value and validator, instead of returning
+// [ErrEOF]. In accessible mode, end of input always returns [ErrEOF]
+// unless it ends a partly typed line, which is submitted.
+func (t *text) WithEOFSubmit() *text {
+	t.eofSubmit = true
+	return t
+}
+
+// WithEchoTransform renders the input through fn while typing, e.g. to
+// group digits or show a formatted preview. Only the displayed text is
+// affected: validators and [text.Render] still see the raw input, and the
+// cursor is placed after fn applied to the input before it.
+// Ignored in accessible mode.
+//
+//	code, err := asky.Text().WithEchoTransform(strings.ToUpper).Render()
+func (t *text) WithEchoTransform(fn func(string) string) *text {
+	t.echoFn = fn
+	return t
+}
+
+// WithInline draws the prompt as a single "label: input" line, with any
+// validation message appended after the input and no help line, for quick
+// questions on short terminals.
+func (t *text) WithInline() *text {
+	t.inline = true
+	return t
+}
+
+// WithBeforeRender sets fn to run once before the prompt is drawn, with
+// the output writer, e.g. to print a header above the prompt.
+func (t *text) WithBeforeRender(fn func(w io.Writer)) *text {
+	t.beforeRender = fn
+	return t
+}
+
+// WithAfterRender sets fn to run once after the prompt is answered or
+// cancelled and its frame cleared, with the output writer.
+func (t *text) WithAfterRender(fn func(w io.Writer)) *text {
+	t.afterRender = fn
+	return t
+}
+
+// WithInterruptHandler sets fn to run when Ctrl+C cancels the prompt, just
+// before Render returns [ErrInterrupted], e.g. to save a draft.
+func (t *text) WithInterruptHandler(fn func()) *text {
+	t.onInterrupt = fn
+	return t
+}
+
 // WithEcho sets how typed characters are displayed.
 // Defaults to [EchoMask]. Use [EchoSilent] for no visual feedback.
 //
 //	pass, err := asky.Secret().WithEcho(asky.EchoSilent).WithLabel("API Key").Render()
 func (s *secret) WithEcho(m EchoMode) *secret {
+	if s.conceal && m == echoNormal {
+		m = EchoMask
+	}
 	s.echo = m
 	return s
 }
 
+// WithConceal guarantees the entered value is never written to the output,
+// even by mistake: plain echo falls back to [EchoMask], and any occurrence
+// of the value in a validation message, or of the default value in the
+// prompt, is replaced by asterisks. Validators and [text.Render] still
+// receive the real value.
+func (s *secret) WithConceal() *secret {
+	s.conceal = true
+	if s.echo == echoNormal {
+		s.echo = EchoMask
+	}
+	return s
+}
+
 // WithStyles overrides the [StyleMap] for this prompt.
 func (s *secret) WithStyles(st *StyleMap) *secret {
 	s.cfg.Styles = st
@@ -120,6 +269,26 @@ func (s *secret) WithPrefix(p string) *secret {
 	return s
 }
 
+// WithPrefixHidden removes the prompt prefix and the space after it.
+func (s *secret) WithPrefixHidden() *secret {
+	s.hidePrefix = true
+	return s
+}
+
+// WithRequiredMarker shows a "*" after the label to mark the prompt as
+// required. The marker is omitted while the prompt has a default value.
+func (s *secret) WithRequiredMarker() *secret {
+	s.showRequired = true
+	return s
+}
+
+// WithIconFallback sets the prefix to emoji, or to ascii on terminals
+// and locales where emoji are unlikely to render correctly.
+func (s *secret) WithIconFallback(emoji, ascii string) *secret {
+	s.prefix = iconFor(emoji, ascii)
+	return s
+}
+
 // WithLabel sets the prompt label shown to the user.
 func (s *secret) WithLabel(l string) *secret {
 	s.label = l
@@ -133,16 +302,108 @@ func (s *secret) WithValidator(fn func(string) (string, bool)) *secret {
 	return s
 }
 
+// WithKeyHandler installs a hook consulted before the prompt's own key
+// handling. Returning handled skips the default action for that key;
+// returning stop exits the prompt with [ErrStopped]. Ignored in accessible mode.
+func (s *secret) WithKeyHandler(fn func(k Key) (handled, stop bool)) *secret {
+	s.keyHandler = fn
+	return s
+}
+
+// WithSubmitKey rebinds the key that submits the prompt from Enter to
+// code, e.g. [KeyCtrlS] for terminals where Enter is unreliable. Enter is
+// then ignored, and the help line names the new key. Ignored in
+// accessible mode.
+func (s *secret) WithSubmitKey(code KeyCode) *secret {
+	s.submitKey = code
+	return s
+}
+
+// WithEchoOnSubmit replaces the prompt, once the input is submitted, with a
+// single line built from format, whose {label} and {value} placeholders are
+// filled with the prompt label and the answer as it was echoed while
+// typing. Ignored in accessible mode, where the answer is already part of
+// the transcript.
+//
+//	.WithEchoOnSubmit("✓ {label}: {value}")
+func (s *secret) WithEchoOnSubmit(format string) *secret {
+	s.echoFormat = format
+	return s
+}
+
+// WithBell rings the terminal bell when an action is rejected, such as
+// a failed validation on submit.
+func (s *secret) WithBell() *secret {
+	s.bell = true
+	return s
+}
+
+// WithEOFSubmit makes Ctrl+D on an empty line submit the prompt like
+// Enter instead of returning [ErrEOF].
+func (s *secret) WithEOFSubmit() *secret {
+	s.eofSubmit = true
+	return s
+}
+
+// WithInline draws the prompt as a single "label: input" line, with any
+// validation message appended after the input and no help line, for quick
+// questions on short terminals.
+func (s *secret) WithInline() *secret {
+	s.inline = true
+	return s
+}
+
+// WithBeforeRender sets fn to run once before the prompt is drawn, with
+// the output writer, e.g. to print a header above the prompt.
+func (s *secret) WithBeforeRender(fn func(w io.Writer)) *secret {
+	s.beforeRender = fn
+	return s
+}
+
+// WithAfterRender sets fn to run once after the prompt is answered or
+// cancelled and its frame cleared, with the output writer.
+func (s *secret) WithAfterRender(fn func(w io.Writer)) *secret {
+	s.afterRender = fn
+	return s
+}
+
+// WithInterruptHandler sets fn to run when Ctrl+C cancels the prompt, just
+// before Render returns [ErrInterrupted], e.g. to save a draft.
+func (s *secret) WithInterruptHandler(fn func()) *secret {
+	s.onInterrupt = fn
+	return s
+}
+
+// WithCursorStyle sets the cursor shape shown while the prompt is active.
+// The terminal's default cursor is restored when the prompt exits.
+func (s *secret) WithCursorStyle(style CursorStyle) *secret {
+	s.cursorStyle = style
+	return s
+}
+
 // Render displays the interactive prompt and blocks until the user submits or
 // cancels. Returns the entered string, or [ErrInterrupted] if Ctrl+C is pressed.
+// Ctrl+L clears the whole input; it does not redraw or clear the screen.
+// Ctrl+D deletes forward, or on an empty line returns [ErrEOF] (see
+// [text.WithEOFSubmit] to submit instead).
 //
-// In accessible mode, input is collected line-by-line.
-// Validation is checked on Enter and the prompt reprints until satisfied.
-func (t *text) Render() (string, error) {
+// The input is returned exactly as typed, including surrounding whitespace.
+// In accessible mode, input is collected line-by-line and only the line
+// terminator is removed. Validation is checked on Enter and the prompt
+// reprints until satisfied.
+func (t *text) Render() (_ string, err error) {
+	runHook(t.beforeRender)
+	defer runHook(t.afterRender)
+	defer runInterruptHandler(t.onInterrupt, &err)
+
 	if t.cfg.Accessible {
 		return t.renderAccessible()
 	}
-	return t.renderInteractive()
+	value, err := t.renderInteractive()
+	if err == nil && t.echoFormat != "" {
+		echoAnswer(t.echoFormat, t.cfg.Styles, "{label}", t.label, "{value}", t.displayBuf([]rune(value)))
+	}
+	return value, err
 }
 
 // renderAccessible collects input without cursor magic.
@@ -150,9 +411,9 @@ func (t *text) Render() (string, error) {
 // Secret echoes * per character; silent echoes nothing.
 // Validation is checked on Enter and the prompt reprints on failure.
 func (t *text) renderAccessible() (string, error) {
-	prefix := pick(t.prefix, "(?)")
-	promptLine := safeStyle(t.cfg.Styles.InputPrefix).Sprint(prefix) + " " +
-		safeStyle(t.cfg.Styles.InputLabel).Sprint(t.label)
+	prefix := promptPrefix(t.prefix, t.hidePrefix, t.cfg.Styles.InputPrefix)
+	promptLine := prefix +
+		safeStyle(t.cfg.Styles.InputLabel).Sprint(t.label) + t.requiredMarker()
 
 	placeholder := ""
 	if t.placeholder != "" {
@@ -191,7 +452,12 @@ func (t *text) renderAccessible() (string, error) {
 					if isInterrupt(r.err) {
 						return "", ErrInterrupted
 					}
-					return "", r.err
+					if errors.Is(r.err, io.EOF) && len(r.b) == 0 {
+						return "", ErrEOF
+					}
+					if !errors.Is(r.err, io.EOF) {
+						return "", r.err
+					}
 				}
 				if t.echo == EchoMask {
 					stdOutput.Write([]byte(strings.Repeat("*", len(r.b)) + "\n"))
@@ -224,7 +490,12 @@ func (t *text) renderAccessible() (string, error) {
 					if isInterrupt(r.err) {
 						return "", ErrInterrupted
 					}
-					return "", r.err
+					if errors.Is(r.err, io.EOF) && r.line == "" {
+						return "", ErrEOF
+					}
+					if !errors.Is(r.err, io.EOF) {
+						return "", r.err
+					}
 				}
 				result = strings.TrimRight(r.line, "\r\n")
 			}
@@ -233,7 +504,10 @@ func (t *text) renderAccessible() (string, error) {
 		if t.validator != nil {
 			msg, ok := t.validator(result)
 			if !ok {
-				stdOutput.Write([]byte(safeStyle(t.cfg.Styles.InputValidationFail).Sprint(msg) + "\n\n"))
+				if t.bell {
+					bell()
+				}
+				stdOutput.Write([]byte(safeStyle(t.cfg.Styles.InputValidationFail).Sprint(t.redact(msg, result)) + "\n\n"))
 				continue
 			}
 		}
@@ -255,10 +529,11 @@ func (t *text) renderInteractive() (string, error) {
 
 	var (
 		cursorRow     = 0 // zero-based row of cursor within the prompt+input line
-		prefix        = pick(t.prefix, "(?)")
 		inBuf         []rune
 		cursorPos     = 0
 		interrupted   = false
+		stopped       = false
+		eof           = false
 		receivedInput = false
 		firstRender   = true
 	)
@@ -269,50 +544,17 @@ func (t *text) renderInteractive() (string, error) {
 	}
 
 	// Build static segments
-	prompt := safeStyle(t.cfg.Styles.InputPrefix).Sprint(prefix) + " " +
-		safeStyle(t.cfg.Styles.InputLabel).Sprint(t.label) + ": "
-	helpLine := safeStyle(t.cfg.Styles.InputHelp).Sprint("enter to confirm  •  ctrl+c to cancel")
-
-	// displayBuf returns the string to render based on echo mode.
-	displayBuf := func(buf []rune) string {
-		switch t.echo {
-		case EchoMask:
-			return strings.Repeat("*", len(buf))
-		case EchoSilent:
-			return ""
-		default:
-			return string(buf)
-		}
-	}
-
-	// buildInputContent returns the inline input content based on buffer state.
-	buildInputContent := func() string {
-		if len(inBuf) == 0 {
-			if t.defaultValue != "" && t.placeholder != "" {
-				return safeStyle(t.cfg.Styles.InputPlaceholder).Sprint(t.placeholder + " (default: " + t.defaultValue + ")")
-			} else if t.defaultValue != "" {
-				return safeStyle(t.cfg.Styles.InputPlaceholder).Sprint(t.defaultValue)
-			} else if t.placeholder != "" {
-				return safeStyle(t.cfg.Styles.InputPlaceholder).Sprint(t.placeholder)
-			}
-			return ""
-		}
-		return safeStyle(t.cfg.Styles.InputText).Sprint(displayBuf(inBuf))
-	}
+	prompt := t.promptSegment()
 
 	redraw := func(validationMsg string) {
 		termW, termH, _ := termSize()
 
-		// Build the prompt+input line
-		promptLine := prompt + buildInputContent()
-
 		// Only show validation after user has started typing
-		validationLine := ""
-		if t.validator != nil && receivedInput && validationMsg != "" {
-			validationLine = safeStyle(t.cfg.Styles.InputValidationFail).Sprint(validationMsg)
+		if t.validator == nil || !receivedInput {
+			validationMsg = ""
 		}
 
-		frameLines := []string{promptLine, "", validationLine, helpLine}
+		frameLines := t.frameLines(inBuf, validationMsg)
 		frameHeight := totalPhysicalLines(frameLines, termW)
 
 		// Move cursor back to row 0 of the frame
@@ -352,9 +594,9 @@ func (t *text) renderInteractive() (string, error) {
 			stdOutput.Write([]byte("\r" + prompt))
 			cursorRow = physicalLines(stripAnsi(prompt), termW) - 1
 		} else {
-			before := safeStyle(t.cfg.Styles.InputText).Sprint(displayBuf(inBuf[:cursorPos]))
+			before := safeStyle(t.cfg.Styles.InputText).Sprint(t.displayBuf(inBuf[:cursorPos]))
 			stdOutput.Write([]byte("\r" + prompt + before))
-			plainUpToCursor := stripAnsi(prompt) + displayBuf(inBuf[:cursorPos])
+			plainUpToCursor := stripAnsi(prompt) + t.displayBuf(inBuf[:cursorPos])
 			cursorRow = physicalLines(plainUpToCursor, termW) - 1
 		}
 
@@ -364,25 +606,47 @@ func (t *text) renderInteractive() (string, error) {
 
 	// Prep for render, hide cursor, defer cleanup
 	stdOutput.Write([]byte("\r" + ansiHideCursor))
-	defer func() {
-		ansiCursorUp(cursorRow)
-		stdOutput.Write([]byte("\r" + ansiClearScreen + ansiReset + ansiShowCursor))
-	}()
+	defer func() { restoreTerminal(cursorRow) }()
+	ansiCursorStyle(t.cursorStyle)
 
 	// Initial render
 	redraw("")
 
-	err := listenKeys(func(ev keyEvent) (stop bool) {
-		switch ev.code {
-		case keyCtrlC:
+	err := listenKeys(func(ev Key) (stop bool) {
+		if t.keyHandler != nil {
+			handled, exit := t.keyHandler(ev)
+			if exit {
+				stopped = true
+				return true
+			}
+			if handled {
+				return false
+			}
+		}
+
+		ev, ok := remapSubmitKey(ev, t.submitKey, KeyEnter)
+		if !ok {
+			return false
+		}
+
+		// Ctrl+D on an empty line submits like Enter when configured to
+		if ev.Code == KeyCtrlD && len(inBuf) == 0 && t.eofSubmit {
+			ev.Code = KeyEnter
+		}
+
+		switch ev.Code {
+		case KeyCtrlC:
 			interrupted = true
 			return true
 
-		case keyEnter:
+		case KeyEnter:
 			if t.validator != nil {
 				msg, ok := t.validator(string(inBuf))
 				if !ok {
 					receivedInput = true
+					if t.bell {
+						bell()
+					}
 					redraw(msg)
 					return false
 				}
@@ -393,27 +657,27 @@ func (t *text) renderInteractive() (string, error) {
 			receivedInput = true
 			return true
 
-		case keyLeft:
+		case KeyLeft:
 			if t.echo != EchoSilent && cursorPos > 0 {
 				cursorPos--
 			}
 
-		case keyRight:
+		case KeyRight:
 			if t.echo != EchoSilent && cursorPos < len(inBuf) {
 				cursorPos++
 			}
 
-		case keyHome, keyCtrlHome:
+		case KeyHome, KeyCtrlHome:
 			if t.echo != EchoSilent {
 				cursorPos = 0
 			}
 
-		case keyEnd, keyCtrlEnd:
+		case KeyEnd, KeyCtrlEnd:
 			if t.echo != EchoSilent {
 				cursorPos = len(inBuf)
 			}
 
-		case keyCtrlLeft:
+		case KeyCtrlLeft:
 			if t.echo == echoNormal && cursorPos > 0 {
 				cursorPos--
 				for cursorPos > 0 && inBuf[cursorPos-1] == ' ' {
@@ -424,7 +688,7 @@ func (t *text) renderInteractive() (string, error) {
 				}
 			}
 
-		case keyCtrlRight:
+		case KeyCtrlRight:
 			if t.echo == echoNormal && cursorPos < len(inBuf) {
 				for cursorPos < len(inBuf) && inBuf[cursorPos] == ' ' {
 					cursorPos++
@@ -434,7 +698,7 @@ func (t *text) renderInteractive() (string, error) {
 				}
 			}
 
-		case keyBackspace:
+		case KeyBackspace:
 			if t.echo == EchoSilent {
 				if len(inBuf) > 0 {
 					inBuf = inBuf[:len(inBuf)-1]
@@ -445,19 +709,33 @@ func (t *text) renderInteractive() (string, error) {
 				cursorPos--
 			}
 
-		case keyDelete:
+		case KeyDelete:
+			if t.echo != EchoSilent && cursorPos < len(inBuf) {
+				inBuf = append(inBuf[:cursorPos], inBuf[cursorPos+1:]...)
+			}
+
+		case KeyCtrlD:
+			// End of input on an empty line, forward delete otherwise
+			if len(inBuf) == 0 {
+				eof = true
+				return true
+			}
 			if t.echo != EchoSilent && cursorPos < len(inBuf) {
 				inBuf = append(inBuf[:cursorPos], inBuf[cursorPos+1:]...)
 			}
 
-		case keySpace:
+		case KeyCtrlL:
+			inBuf = inBuf[:0]
+			cursorPos = 0
+
+		case KeySpace:
 			if t.echo != EchoSilent {
 				inBuf = slices.Insert(inBuf, cursorPos, ' ')
 				cursorPos++
 			}
 
-		case keyRune:
-			inBuf = slices.Insert(inBuf, cursorPos, ev.r)
+		case KeyRune:
+			inBuf = slices.Insert(inBuf, cursorPos, ev.Rune)
 			cursorPos++
 		}
 
@@ -482,6 +760,131 @@ func (t *text) renderInteractive() (string, error) {
 	if interrupted {
 		return "", ErrInterrupted
 	}
+	if stopped {
+		return "", ErrStopped
+	}
+	if eof {
+		return "", ErrEOF
+	}
+
+	return string(inBuf), nil
+}
+
+// Preview returns the prompt's initial frame as it would first be drawn,
+// without reading input or moving the cursor. Useful for documentation
+// and layout debugging.
+func (t *text) Preview() string {
+	return strings.Join(t.frameLines(nil, ""), "\n")
+}
+
+// DebugState returns the prompt's resolved configuration on one line, for
+// logging when it renders unexpectedly: whether its styles are the
+// built-in defaults, those set with [Configure], or its own from
+// WithStyles, the accessibility and color switches, and its main options.
+//
+//	log.Println(asky.Text().WithLabel("Name").DebugState())
+//	// prompt=text styles=default accessible=false color=true label="Name" echo="normal" inline=false ...
+func (t *text) DebugState() string {
+	return t.debugState("text")
+}
+
+// DebugState returns the prompt's resolved configuration on one line, as
+// for [text.DebugState]. The default value is reported only as set or
+// not, so the result is safe to log.
+func (s *secret) DebugState() string {
+	return s.debugState("secret")
+}
+
+// debugState formats the text or secret prompt state under kind.
+func (t *text) debugState(kind string) string {
+	echo := "normal"
+	switch t.echo {
+	case EchoMask:
+		echo = "mask"
+	case EchoSilent:
+		echo = "silent"
+	}
+	// Never print a default that the prompt itself would hide
+	var def any = t.defaultValue
+	if kind == "secret" || t.echo != echoNormal || t.conceal {
+		def = t.defaultValue != ""
+	}
+	return debugState(kind, t.cfg,
+		"label", t.label,
+		"echo", echo,
+		"inline", t.inline,
+		"validator", t.validator != nil,
+		"default", def)
+}
+
+// requiredMarker returns the styled required marker, or "" when hidden.
+func (t *text) requiredMarker() string {
+	if !t.showRequired || t.defaultValue != "" {
+		return ""
+	}
+	return safeStyle(t.cfg.Styles.InputValidationFail).Sprint("*")
+}
+
+// promptSegment returns the styled prefix and label that precede the input.
+func (t *text) promptSegment() string {
+	return promptPrefix(t.prefix, t.hidePrefix, t.cfg.Styles.InputPrefix) +
+		safeStyle(t.cfg.Styles.InputLabel).Sprint(t.label) + t.requiredMarker() + ": "
+}
+
+// displayBuf returns the string to render for buf based on echo mode.
+func (t *text) displayBuf(buf []rune) string {
+	switch t.echo {
+	case EchoMask:
+		return strings.Repeat("*", len(buf))
+	case EchoSilent:
+		return ""
+	default:
+		if t.echoFn != nil {
+			return t.echoFn(string(buf))
+		}
+		return string(buf)
+	}
+}
 
-	return strings.TrimRight(string(inBuf), "\r\n"), nil
+// redact masks every occurrence of value in msg when the prompt conceals
+// its input. msg is returned unchanged otherwise.
+func (t *text) redact(msg, value string) string {
+	if !t.conceal || value == "" {
+		return msg
+	}
+	return strings.ReplaceAll(msg, value, strings.Repeat("*", utf8.RuneCountInString(value)))
+}
+
+// inputContent returns the inline input content for buf, falling back to
+// the placeholder and default value while buf is empty.
+func (t *text) inputContent(buf []rune) string {
+	if len(buf) == 0 {
+		defaultValue := t.redact(t.defaultValue, t.defaultValue)
+		if defaultValue != "" && t.placeholder != "" {
+			return safeStyle(t.cfg.Styles.InputPlaceholder).Sprint(t.placeholder + " (default: " + defaultValue + ")")
+		} else if defaultValue != "" {
+			return safeStyle(t.cfg.Styles.InputPlaceholder).Sprint(defaultValue)
+		} else if t.placeholder != "" {
+			return safeStyle(t.cfg.Styles.InputPlaceholder).Sprint(t.placeholder)
+		}
+		return ""
+	}
+	return safeStyle(t.cfg.Styles.InputText).Sprint(t.displayBuf(buf))
+}
+
+// frameLines builds the lines of one frame: the prompt with its input,
+// a spacer, the validation message (if any) and the help line.
+func (t *text) frameLines(buf []rune, validationMsg string) []string {
+	validationLine := ""
+	if validationMsg != "" {
+		validationLine = safeStyle(t.cfg.Styles.InputValidationFail).Sprint(t.redact(validationMsg, string(buf)))
+	}
+	if t.inline {
+		if validationLine != "" {
+			validationLine = "  " + validationLine
+		}
+		return []string{t.promptSegment() + t.inputContent(buf) + validationLine}
+	}
+	helpLine := safeStyle(t.cfg.Styles.InputHelp).Sprint(keyLabel(t.submitKey) + " to confirm  •  ctrl+c to cancel")
+	return []string{t.promptSegment() + t.inputContent(buf), "", validationLine, helpLine}
 }
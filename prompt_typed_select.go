@@ -0,0 +1,99 @@
+package asky
+
+import (
+	"strconv"
+
+	"github.com/fatih/color"
+)
+
+// TypedChoice is a [Choice] whose value is any type, so a selection can
+// carry domain objects directly instead of string keys.
+type TypedChoice[T any] struct {
+	Value T
+	Label string
+
+	// Color optionally overrides the label style, as for [Choice.Color].
+	Color *color.Color
+
+	// Selected marks the choice as a default: the one the cursor starts on
+	// in [SelectTyped], or one of those selected when [MultiSelectTyped]
+	// opens. It stands in for WithSelectedChoice and WithSelectedChoices,
+	// which cannot name a typed value.
+	Selected bool
+}
+
+// SelectTyped renders sel with choices and returns the value of the chosen
+// one. Any choices already set on sel are replaced.
+//
+// The choices reach sel keyed by their index, as a string, in
+// [Choice.Value], so options that work on values see that index:
+// validators receive it, WithShowValues displays it and
+// WithSearchIncludeValue searches it. Defaults come from
+// [TypedChoice.Selected]; a WithSelectedChoice set on sel is discarded.
+//
+//	env, err := asky.SelectTyped(asky.Select().WithLabel("Environment"), []asky.TypedChoice[Env]{
+//	    {Value: dev, Label: "Development"},
+//	    {Value: prod, Label: "Production", Selected: true},
+//	})
+func SelectTyped[T any](sel *singleSelect, choices []TypedChoice[T]) (T, error) {
+	var zero T
+	sel.preSelected = nil
+	for i, c := range choices {
+		if c.Selected {
+			sel.WithSelectedChoice(strconv.Itoa(i))
+			break
+		}
+	}
+	c, err := sel.WithChoices(indexedChoices(choices)).Render()
+	if err != nil {
+		return zero, err
+	}
+	i, err := strconv.Atoi(c.Value)
+	if err != nil {
+		// Nothing was selected
+		return zero, nil
+	}
+	return choices[i].Value, nil
+}
+
+// MultiSelectTyped renders sel with choices and returns the values of the
+// chosen ones, in selection order. Any choices already set on sel are
+// replaced.
+//
+// As with [SelectTyped], the choices reach sel keyed by index, so
+// validators, WithDependencyRule and WithShowValues see the index as a
+// string in [Choice.Value], and [ValidateMultiSelectExclusive] takes
+// indices. Defaults come from [TypedChoice.Selected], which replaces any
+// WithSelectedChoices set on sel; WithAllSelected still applies when no
+// choice is marked.
+func MultiSelectTyped[T any](sel *multiSelect, choices []TypedChoice[T]) ([]T, error) {
+	sel.preSelected = nil
+	var selected []string
+	for i, c := range choices {
+		if c.Selected {
+			selected = append(selected, strconv.Itoa(i))
+		}
+	}
+	if len(selected) > 0 {
+		sel.WithSelectedChoices(selected)
+	}
+	picked, err := sel.WithChoices(indexedChoices(choices)).Render()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]T, 0, len(picked))
+	for _, c := range picked {
+		i, _ := strconv.Atoi(c.Value)
+		values = append(values, choices[i].Value)
+	}
+	return values, nil
+}
+
+// indexedChoices converts choices to plain [Choice] values keyed by index.
+func indexedChoices[T any](choices []TypedChoice[T]) []Choice {
+	plain := make([]Choice, len(choices))
+	for i, c := range choices {
+		plain[i] = Choice{Value: strconv.Itoa(i), Label: c.Label, Color: c.Color}
+	}
+	return plain
+}
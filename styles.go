@@ -1,14 +1,51 @@
 package asky
 
 import (
+	"io"
+	"os"
+
 	"github.com/fatih/color"
 	"github.com/mattn/go-colorable"
+	"golang.org/x/term"
 )
 
 // stdOutput is the colorable stdout used by all Asky components.
 // On Windows, this ensures ANSI escape sequences render correctly.
+// Replaced by [SetOutput].
 var stdOutput = colorable.NewColorableStdout()
 
+// outputRedirected is set while [SetOutput] points output away from stdout.
+var outputRedirected = false
+
+// SetOutput sends everything Asky writes to w instead of stdout, e.g. to
+// capture logs, spinners and progress bars in tests. Spinners and progress
+// bars fall back to their static, line-based output since w is not treated
+// as a terminal. Pass nil to restore stdout. Interactive prompts still read
+// keys from the terminal.
+//
+//	var buf bytes.Buffer
+//	asky.SetOutput(&buf)
+//	defer asky.SetOutput(nil)
+func SetOutput(w io.Writer) {
+	if w == nil {
+		stdOutput, outputRedirected = colorable.NewColorableStdout(), false
+		return
+	}
+	stdOutput, outputRedirected = w, true
+}
+
+// outputIsTerminal reports whether output reaches a terminal: stdout is one
+// and [SetOutput] has not redirected it.
+func outputIsTerminal() bool {
+	return !outputRedirected && stdoutIsTerminal()
+}
+
+// stdoutIsTerminal reports whether stdout is a terminal. Tests replace it
+// to drive the animated paths.
+var stdoutIsTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
 // StyleMap defines the visual appearance of all Asky TUI components.
 // Every field is a [*color.Color] from the fatih/color package — assign
 // any value constructed with [color.New] to override a specific style.
@@ -41,9 +78,10 @@ type StyleMap struct {
 	InputHelp           *color.Color
 
 	// Confirmation prompt styles.
-	ConfirmationPrefix *color.Color
-	ConfirmationLabel  *color.Color
-	ConfirmationHelp   *color.Color
+	ConfirmationPrefix       *color.Color
+	ConfirmationLabel        *color.Color
+	ConfirmationHelp         *color.Color
+	ConfirmationSelectedItem *color.Color
 
 	// Selection prompt styles.
 	SelectionPrefix             *color.Color
@@ -59,6 +97,9 @@ type StyleMap struct {
 	SelectionItemCurrentLabel   *color.Color
 	SelectionItemSelectedMarker *color.Color
 	SelectionItemSelectedLabel  *color.Color
+	SelectionItemDefaultHint    *color.Color
+	SelectionSearchMatch        *color.Color
+	SelectionItemDisabledLabel  *color.Color
 
 	// Spinner styles.
 	SpinnerPrefix *color.Color
@@ -73,6 +114,10 @@ type StyleMap struct {
 	ProgressBarStatus  *color.Color
 }
 
+// defaultStyles is the StyleMap in effect until [Configure] replaces it,
+// kept so DebugState can tell the built-in styles apart.
+var defaultStyles = NewStyles()
+
 // NewStyles returns a [StyleMap] with sensible default colors.
 //
 // The palette uses sharp and distinctive colors with semantic states
@@ -102,9 +147,10 @@ func NewStyles() *StyleMap {
 		InputHelp:           color.New(color.FgHiBlack),
 
 		// Confirmation prompts
-		ConfirmationPrefix: color.New(color.FgYellow),
-		ConfirmationLabel:  color.New(color.Reset),
-		ConfirmationHelp:   color.New(color.FgHiBlack),
+		ConfirmationPrefix:       color.New(color.FgYellow),
+		ConfirmationLabel:        color.New(color.Reset),
+		ConfirmationHelp:         color.New(color.FgHiBlack),
+		ConfirmationSelectedItem: color.New(color.FgHiYellow, color.Bold),
 
 		// Selection prompts
 		SelectionPrefix:             color.New(color.FgYellow),
@@ -120,6 +166,9 @@ func NewStyles() *StyleMap {
 		SelectionItemCurrentLabel:   color.New(color.FgHiYellow),
 		SelectionItemSelectedMarker: color.New(color.FgGreen),
 		SelectionItemSelectedLabel:  color.New(color.FgGreen),
+		SelectionItemDefaultHint:    color.New(color.FgHiBlack),
+		SelectionSearchMatch:        color.New(color.FgYellow, color.Underline),
+		SelectionItemDisabledLabel:  color.New(color.FgHiBlack, color.CrossedOut),
 
 		// Spinners
 		SpinnerPrefix: color.New(color.FgYellow),
@@ -4,8 +4,12 @@ import (
 	"errors"
 	"io"
 	"os"
+	"runtime"
+	"strconv"
 	"strings"
 	"syscall"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/fatih/color"
 	"github.com/mattn/go-runewidth"
@@ -13,10 +17,20 @@ import (
 )
 
 // termSize returns the current terminal width and height in columns and rows.
-func termSize() (int, int, error) {
+// Tests replace it to lay out frames at a fixed size.
+var termSize = func() (int, int, error) {
 	return term.GetSize(int(os.Stdout.Fd()))
 }
 
+// previewSize returns the terminal size used to lay out a Preview frame,
+// falling back to 80x24 when stdout is not a terminal.
+func previewSize() (int, int) {
+	if w, h, err := termSize(); err == nil {
+		return w, h
+	}
+	return 80, 24
+}
+
 // reserveLines writes n blank lines to stdout then moves the cursor back up,
 // reserving vertical space for a component to render into.
 // Returns [ErrTerminalTooSmall] if the terminal has fewer than the
@@ -42,6 +56,44 @@ func safeStyle(s *color.Color) *color.Color {
 	return color.New(color.Reset)
 }
 
+// emojiSafe reports whether emoji are likely to render correctly and at
+// their expected width. It requires a UTF-8 locale (or Windows Terminal on
+// Windows), a terminal other than the Linux console, and a locale that does
+// not treat ambiguous-width runes as wide.
+func emojiSafe() bool {
+	if runtime.GOOS == "windows" {
+		return os.Getenv("WT_SESSION") != ""
+	}
+	switch os.Getenv("TERM") {
+	case "linux", "dumb":
+		return false
+	}
+	if runewidth.IsEastAsian() {
+		return false
+	}
+	return utf8Locale()
+}
+
+// utf8Locale reports whether the effective locale (the first of LC_ALL,
+// LC_CTYPE and LANG that is set) uses UTF-8 encoding.
+func utf8Locale() bool {
+	for _, env := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(env); v != "" {
+			v = strings.ToLower(v)
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+	return false
+}
+
+// iconFor returns emoji when [emojiSafe] reports emoji support, otherwise ascii.
+func iconFor(emoji, ascii string) string {
+	if emojiSafe() {
+		return emoji
+	}
+	return ascii
+}
+
 // pick returns val if non-empty, otherwise fallback.
 func pick(val, fallback string) string {
 	if val != "" {
@@ -92,7 +144,7 @@ func stripAnsi(s string) string {
 // after stripping ANSI escape sequences from s.
 func physicalLines(s string, termWidth int) int {
 	visible := runewidth.StringWidth(stripAnsi(s))
-	if visible == 0 {
+	if visible == 0 || termWidth <= 0 {
 		return 1
 	}
 	return (visible + termWidth - 1) / termWidth
@@ -130,3 +182,157 @@ func TruncToWidth(content string, availableWidth int) string {
 	}
 	return truncated.String() + "…"
 }
+
+// wrapToWidth word-wraps content into lines no wider than width columns.
+// Existing newlines are preserved, and lines that already fit are
+// returned untouched. Longer lines break at whitespace, which is dropped
+// at each break; spacing elsewhere, including leading indentation, is
+// kept. Words wider than width are broken at rune boundaries. Widths
+// ignore ANSI escape sequences, so pre-styled content wraps at the
+// visible column. Returns content unchanged as a single line if width is
+// not positive.
+func wrapToWidth(content string, width int) []string {
+	if width <= 0 {
+		return []string{content}
+	}
+	var lines []string
+	for _, para := range strings.Split(content, "\n") {
+		if runewidth.StringWidth(stripAnsi(para)) <= width {
+			lines = append(lines, para)
+			continue
+		}
+		var line strings.Builder
+		lineWidth := 0
+		space := ""
+		for _, tok := range splitSpaceRuns(para) {
+			if strings.TrimSpace(tok) == "" {
+				space = tok
+				continue
+			}
+			word, wordWidth := tok, runewidth.StringWidth(stripAnsi(tok))
+			spaceWidth := runewidth.StringWidth(space)
+			if lineWidth > 0 && lineWidth+spaceWidth+wordWidth > width {
+				lines = append(lines, line.String())
+				line.Reset()
+				lineWidth, space, spaceWidth = 0, "", 0
+			}
+			line.WriteString(space)
+			lineWidth += spaceWidth
+			space = ""
+			for lineWidth+wordWidth > width {
+				// Hard-break words that cannot fit on any line
+				head, rest := splitAtWidth(word, width-lineWidth)
+				lines = append(lines, line.String()+head)
+				line.Reset()
+				lineWidth = 0
+				word, wordWidth = rest, runewidth.StringWidth(stripAnsi(rest))
+			}
+			line.WriteString(word)
+			lineWidth += wordWidth
+		}
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// splitSpaceRuns splits s into alternating runs of whitespace and of
+// other characters, so that joining the runs gives back s.
+func splitSpaceRuns(s string) []string {
+	var runs []string
+	start, inSpace := 0, false
+	for i, r := range s {
+		if sp := unicode.IsSpace(r); sp != inSpace {
+			if i > start {
+				runs = append(runs, s[start:i])
+				start = i
+			}
+			inSpace = sp
+		}
+	}
+	if start < len(s) {
+		runs = append(runs, s[start:])
+	}
+	return runs
+}
+
+// splitAtWidth splits s into a head no wider than width columns and the
+// remaining tail. At least one rune is always placed in head. ANSI escape
+// sequences take no columns and are never split.
+func splitAtWidth(s string, width int) (string, string) {
+	used, placed := 0, false
+	for i := 0; i < len(s); {
+		if n := ansiLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		rw := runewidth.RuneWidth(r)
+		if used+rw > width && placed {
+			return s[:i], s[i:]
+		}
+		used += rw
+		placed = true
+		i += size
+	}
+	return s, ""
+}
+
+// ansiLen returns the byte length of the escape sequence at the start of
+// s, using the same rules as stripAnsi, or 0 if s does not start with one.
+func ansiLen(s string) int {
+	if len(s) < 2 || s[0] != '\033' {
+		return 0
+	}
+	if s[1] != '[' {
+		return 2
+	}
+	i := 2
+	for i < len(s) && !((s[i] >= 'A' && s[i] <= 'Z') || (s[i] >= 'a' && s[i] <= 'z')) {
+		i++
+	}
+	return min(i+1, len(s))
+}
+
+// groupThousands formats n with a comma between each group of three
+// digits, e.g. 1234567 as "1,234,567".
+func groupThousands(n int) string {
+	digits := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(d)
+	}
+	return sign + b.String()
+}
+
+// repeatToWidth repeats s as many times as fits in width display columns,
+// padding any remainder with spaces, so wide characters never overflow.
+// A zero-width s yields spaces only.
+func repeatToWidth(s string, width int) string {
+	w := runewidth.StringWidth(s)
+	if w <= 0 || width <= 0 {
+		return strings.Repeat(" ", max(width, 0))
+	}
+	return strings.Repeat(s, width/w) + strings.Repeat(" ", width%w)
+}
+
+// displayWidth returns the display width of s. It follows runewidth,
+// except that a narrow symbol followed by the emoji presentation selector
+// (U+FE0F), as in "✔️", counts as two columns, since terminals draw it as
+// an emoji. Used for selection rows, whose markers are often such symbols.
+func displayWidth(s string) int {
+	w, prev := runewidth.StringWidth(s), rune(0)
+	for _, r := range s {
+		if r == '\uFE0F' && runewidth.RuneWidth(prev) == 1 {
+			w++
+		}
+		prev = r
+	}
+	return w
+}
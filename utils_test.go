@@ -0,0 +1,79 @@
+package asky
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestWrapToWidth(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		width   int
+		want    []string
+	}{
+		{"fits untouched", "  keep   its\tspacing", 80, []string{"  keep   its\tspacing"}},
+		{"breaks at spaces", "aaa bbb ccc", 7, []string{"aaa bbb", "ccc"}},
+		{"keeps indentation", "  aaa bbb ccc", 9, []string{"  aaa bbb", "ccc"}},
+		{"keeps newlines", "a b\n  c", 80, []string{"a b", "  c"}},
+		{"hard-breaks long words", "abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"wide runes", "日本語 テキスト", 6, []string{"日本語", "テキス", "ト"}},
+		{"styled words", "\x1b[31maaa\x1b[0m \x1b[31mbbb\x1b[0m", 5, []string{"\x1b[31maaa\x1b[0m", "\x1b[31mbbb\x1b[0m"}},
+		{"no width", "a b", 0, []string{"a b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wrapToWidth(tt.content, tt.width); !slices.Equal(got, tt.want) {
+				t.Errorf("wrapToWidth(%q, %d) = %q, want %q", tt.content, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapToWidthStyledLabel(t *testing.T) {
+	// A 200-column colored label wraps at the visible column, not at the
+	// byte length of the escape sequences
+	label := strings.TrimSpace(strings.Repeat("word ", 40))
+	label += strings.Repeat("x", 200-len(label))
+	styled := "\x1b[31m" + label + "\x1b[0m"
+
+	lines := wrapToWidth(styled, 80)
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3: %q", len(lines), lines)
+	}
+	var words []string
+	for _, line := range lines {
+		if w := displayWidth(stripAnsi(line)); w > 80 {
+			t.Errorf("line %q is %d columns wide, want at most 80", line, w)
+		}
+		words = append(words, strings.Fields(stripAnsi(line))...)
+	}
+	if got := strings.Join(words, " "); got != label {
+		t.Errorf("wrapped text = %q, want %q", got, label)
+	}
+}
+
+func TestSplitAtWidth(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		width      int
+		head, tail string
+	}{
+		{"plain", "abcdef", 3, "abc", "def"},
+		{"fits", "abc", 5, "abc", ""},
+		{"wide runes", "日本語", 4, "日本", "語"},
+		{"wide rune past width", "a日", 2, "a", "日"},
+		{"always places a rune", "日本", 1, "日", "本"},
+		{"skips escapes", "\x1b[31mabcdef\x1b[0m", 3, "\x1b[31mabc", "def\x1b[0m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			head, tail := splitAtWidth(tt.s, tt.width)
+			if head != tt.head || tail != tt.tail {
+				t.Errorf("splitAtWidth(%q, %d) = %q, %q, want %q, %q", tt.s, tt.width, head, tail, tt.head, tt.tail)
+			}
+		})
+	}
+}
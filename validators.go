@@ -453,6 +453,25 @@ func ValidateMultiSelectMinMax(min, max int) func([]Choice) (string, bool) {
 	}
 }
 
+// ValidateMultiSelectExclusive fails if more than one of the choices with
+// the given values is selected, for options that cannot be combined.
+//
+//	asky.ValidateMultiSelectExclusive("none", "all")
+func ValidateMultiSelectExclusive(values ...string) func([]Choice) (string, bool) {
+	return func(choices []Choice) (string, bool) {
+		var picked []string
+		for _, c := range choices {
+			if slices.Contains(values, c.Value) {
+				picked = append(picked, c.Label)
+			}
+		}
+		if len(picked) > 1 {
+			return strings.Join(picked, " and ") + " cannot be selected together", false
+		}
+		return "", true
+	}
+}
+
 // --- helpers ---------------------------------------------
 
 // formatFloat formats a float64 as an integer string if it has no fractional